@@ -107,6 +107,9 @@ type AssurancePolicy struct {
 	OpenshiftHardeningEnabled   bool                    `json:"openshift_hardening_enabled"`
 	KubernetesControlsAvdIds    []string                `json:"kubernetes_controls_avd_ids"`
 	VulnerabilityScoreRange     []int                   `json:"vulnerability_score_range"`
+
+	IgnoreRecentlyPublishedFixVln       bool `json:"ignore_recently_published_fix_vln"`
+	IgnoreRecentlyPublishedFixVlnPeriod int  `json:"ignore_recently_published_fix_vln_period"`
 }
 
 type Checks struct {
@@ -184,24 +187,45 @@ type KubernetesControls struct {
 
 type KubernetesControlsArray []KubernetesControls
 
+// AssuranceType is the type of an assurance policy, which is also the path segment of its API
+type AssuranceType string
+
+const (
+	AssuranceTypeImage         AssuranceType = "image"
+	AssuranceTypeHost          AssuranceType = "host"
+	AssuranceTypeFunction      AssuranceType = "function"
+	AssuranceTypeKubernetes    AssuranceType = "kubernetes"
+	AssuranceTypeCFApplication AssuranceType = "cf_application"
+)
+
+// AssuranceTypes lists all the assurance policy types
+var AssuranceTypes = []AssuranceType{
+	AssuranceTypeImage,
+	AssuranceTypeHost,
+	AssuranceTypeFunction,
+	AssuranceTypeKubernetes,
+	AssuranceTypeCFApplication,
+}
+
+// ParseAssuranceType returns the assurance type matching assuranceType case insensitively, or an error for an unknown type
+func ParseAssuranceType(assuranceType string) (AssuranceType, error) {
+	for _, t := range AssuranceTypes {
+		if strings.EqualFold(assuranceType, string(t)) {
+			return t, nil
+		}
+	}
+	return "", fmt.Errorf("unknown assurance type %q, expected one of %v", assuranceType, AssuranceTypes)
+}
+
 // GetAssurancePolicy - returns single  Assurance Policy
 func (cli *Client) GetAssurancePolicy(name string, assuranceType string) (*AssurancePolicy, error) {
-	var err error
 	var response AssurancePolicy
-	var atype string
-	if strings.EqualFold(assuranceType, "host") {
-		atype = "host"
-	} else if strings.EqualFold(assuranceType, "image") {
-		atype = "image"
-	} else if strings.EqualFold(assuranceType, "function") {
-		atype = "function"
-	} else if strings.EqualFold(assuranceType, "kubernetes") {
-		atype = "kubernetes"
-	} else if strings.EqualFold(assuranceType, "cf_application") {
-		atype = "cf_application"
+	atype, err := ParseAssuranceType(assuranceType)
+	if err != nil {
+		return nil, err
 	}
 
-	apiPath := "/api/v2/assurance_policy/" + atype + "/" + name
+	apiPath := "/api/v2/assurance_policy/" + string(atype) + "/" + name
 	err = cli.limiter.Wait(context.Background())
 	if err != nil {
 		return nil, err
@@ -239,24 +263,15 @@ func (cli *Client) GetAssurancePolicy(name string, assuranceType string) (*Assur
 
 // CreateAssurancePolicy - creates single Khulnasoft  Assurance Policy
 func (cli *Client) CreateAssurancePolicy(assurancePolicy *AssurancePolicy, assuranceType string) error {
-	payload, err := json.Marshal(assurancePolicy)
-	var atype string
-	if strings.EqualFold(assuranceType, "host") {
-		atype = "host"
-	} else if strings.EqualFold(assuranceType, "image") {
-		atype = "image"
-	} else if strings.EqualFold(assuranceType, "function") {
-		atype = "function"
-	} else if strings.EqualFold(assuranceType, "kubernetes") {
-		atype = "kubernetes"
-	} else if strings.EqualFold(assuranceType, "cf_application") {
-		atype = "cf_application"
+	atype, err := ParseAssuranceType(assuranceType)
+	if err != nil {
+		return err
 	}
-
-	apiPath := "/api/v2/assurance_policy/" + atype
+	payload, err := json.Marshal(assurancePolicy)
 	if err != nil {
 		return err
 	}
+	apiPath := "/api/v2/assurance_policy/" + string(atype)
 	request := cli.gorequest
 	err = cli.limiter.Wait(context.Background())
 	if err != nil {
@@ -289,19 +304,11 @@ func (cli *Client) UpdateAssurancePolicy(assurancePolicy *AssurancePolicy, assur
 	if err != nil {
 		return err
 	}
-	var atype string
-	if strings.EqualFold(assuranceType, "host") {
-		atype = "host"
-	} else if strings.EqualFold(assuranceType, "image") {
-		atype = "image"
-	} else if strings.EqualFold(assuranceType, "function") {
-		atype = "function"
-	} else if strings.EqualFold(assuranceType, "kubernetes") {
-		atype = "kubernetes"
-	} else if strings.EqualFold(assuranceType, "cf_application") {
-		atype = "cf_application"
+	atype, err := ParseAssuranceType(assuranceType)
+	if err != nil {
+		return err
 	}
-	apiPath := "/api/v2/assurance_policy/" + atype + "/" + assurancePolicy.Name
+	apiPath := "/api/v2/assurance_policy/" + string(atype) + "/" + assurancePolicy.Name
 	request := cli.gorequest
 	err = cli.limiter.Wait(context.Background())
 	if err != nil {
@@ -331,20 +338,12 @@ func (cli *Client) UpdateAssurancePolicy(assurancePolicy *AssurancePolicy, assur
 // DeleteAssurancePolicy removes a  Assurance Policy
 func (cli *Client) DeleteAssurancePolicy(name string, assuranceType string) error {
 	request := cli.gorequest
-	var atype string
-	if strings.EqualFold(assuranceType, "host") {
-		atype = "host"
-	} else if strings.EqualFold(assuranceType, "image") {
-		atype = "image"
-	} else if strings.EqualFold(assuranceType, "function") {
-		atype = "function"
-	} else if strings.EqualFold(assuranceType, "kubernetes") {
-		atype = "kubernetes"
-	} else if strings.EqualFold(assuranceType, "cf_application") {
-		atype = "cf_application"
+	atype, err := ParseAssuranceType(assuranceType)
+	if err != nil {
+		return err
 	}
-	apiPath := "/api/v2/assurance_policy/" + atype + "/" + name
-	err := cli.limiter.Wait(context.Background())
+	apiPath := "/api/v2/assurance_policy/" + string(atype) + "/" + name
+	err = cli.limiter.Wait(context.Background())
 	if err != nil {
 		return err
 	}
@@ -368,3 +367,67 @@ func (cli *Client) DeleteAssurancePolicy(name string, assuranceType string) erro
 	}
 	return nil
 }
+
+// KubernetesControlsList is a page of the Kubernetes controls catalog
+type KubernetesControlsList struct {
+	Count    int                     `json:"count"`
+	Page     int                     `json:"page"`
+	Pagesize int                     `json:"pagesize"`
+	Result   KubernetesControlsArray `json:"result"`
+}
+
+// GetKubernetesControls gets the catalog of the Kubernetes controls available to assurance policies
+func (cli *Client) GetKubernetesControls() (KubernetesControlsArray, error) {
+	var controls KubernetesControlsArray
+
+	var page = 1
+	var pagesize = 100
+	var total = 0
+	for {
+		response, err := cli.getKubernetesControls(page, pagesize)
+		if err != nil {
+			return nil, err
+		}
+		total = total + pagesize
+		page++
+		controls = append(controls, response.Result...)
+		if total-response.Count >= 0 || len(response.Result) == 0 {
+			break
+		}
+	}
+
+	return controls, nil
+}
+
+func (cli *Client) getKubernetesControls(page, pagesize int) (*KubernetesControlsList, error) {
+	var err error
+	var response KubernetesControlsList
+	request := cli.gorequest
+	apiPath := fmt.Sprintf("/api/v2/image_assurance/kubernetes_controls?page=%v&pagesize=%v", page, pagesize)
+	err = cli.limiter.Wait(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	events, body, errs := request.Clone().Set("Authorization", "Bearer "+cli.token).Get(cli.url + apiPath).End()
+	if errs != nil {
+		return nil, errors.Wrap(getMergedError(errs), "failed getting kubernetes controls")
+	}
+	if events.StatusCode == 200 {
+		err = json.Unmarshal([]byte(body), &response)
+		if err != nil {
+			log.Printf("Error unmarshaling response body")
+			return nil, errors.Wrap(err, fmt.Sprintf("couldn't unmarshal get kubernetes controls response. Body: %v", body))
+		}
+	} else {
+		var errorReponse ErrorResponse
+		err = json.Unmarshal([]byte(body), &errorReponse)
+		if err != nil {
+			log.Println("failed to unmarshal error response")
+			return nil, fmt.Errorf("failed getting kubernetes controls. Status: %v, Response: %v", events.StatusCode, body)
+		}
+
+		return nil, fmt.Errorf("failed getting kubernetes controls. Status: %v, error message: %v", events.StatusCode, errorReponse.Message)
+	}
+
+	return &response, nil
+}
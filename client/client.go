@@ -268,6 +268,11 @@ func (cli *Client) SetUrl(url string) {
 	cli.url = url
 }
 
+// GetUsername returns the user the client authenticates as, empty when using API key authentication
+func (cli *Client) GetUsername() string {
+	return cli.user
+}
+
 func (cli *Client) GetAuthToken() (string, string, error) {
 	var err error
 
@@ -0,0 +1,224 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/pkg/errors"
+)
+
+// ComplianceScript is a custom compliance check script, run by the assurance policies listing it in their custom
+// checks
+type ComplianceScript struct {
+	ScriptID     string `json:"script_id,omitempty"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	Engine       string `json:"engine"`
+	Path         string `json:"path,omitempty"`
+	Snippet      string `json:"snippet"`
+	Severity     string `json:"severity"`
+	Author       string `json:"author,omitempty"`
+	LastModified int    `json:"last_modified,omitempty"`
+	ReadOnly     bool   `json:"read_only,omitempty"`
+}
+
+// ComplianceScriptList is a page of custom compliance scripts
+type ComplianceScriptList struct {
+	Count    int                `json:"count"`
+	Page     int                `json:"page"`
+	Pagesize int                `json:"pagesize"`
+	Result   []ComplianceScript `json:"result"`
+}
+
+// GetComplianceScript gets a custom compliance script by ID
+func (cli *Client) GetComplianceScript(id string) (*ComplianceScript, error) {
+	var err error
+	var response ComplianceScript
+	request := cli.gorequest
+	apiPath := fmt.Sprintf("/api/v2/image_assurance/user_scripts/%s", id)
+	err = cli.limiter.Wait(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	events, body, errs := request.Clone().Set("Authorization", "Bearer "+cli.token).Get(cli.url + apiPath).End()
+	if errs != nil {
+		return nil, errors.Wrap(getMergedError(errs), "failed getting custom compliance script with id "+id)
+	}
+	if events.StatusCode == 200 {
+		err = json.Unmarshal([]byte(body), &response)
+		if err != nil {
+			log.Printf("Error unmarshaling response body")
+			return nil, errors.Wrap(err, fmt.Sprintf("couldn't unmarshal get custom compliance script response. Body: %v", body))
+		}
+	} else {
+		var errorReponse ErrorResponse
+		err = json.Unmarshal([]byte(body), &errorReponse)
+		if err != nil {
+			log.Println("failed to unmarshal error response")
+			return nil, fmt.Errorf("failed getting custom compliance script with id %v. Status: %v, Response: %v", id, events.StatusCode, body)
+		}
+
+		return nil, fmt.Errorf("failed getting custom compliance script with id %v. Status: %v, error message: %v", id, events.StatusCode, errorReponse.Message)
+	}
+
+	if response.ScriptID == "" {
+		return nil, fmt.Errorf("custom compliance script: %s not found 404", id)
+	}
+	return &response, nil
+}
+
+// GetComplianceScripts gets all the custom compliance scripts
+func (cli *Client) GetComplianceScripts() ([]ComplianceScript, error) {
+	var scripts []ComplianceScript
+
+	var page = 1
+	var pagesize = 100
+	var total = 0
+	for {
+		response, err := cli.getComplianceScripts(page, pagesize)
+		if err != nil {
+			return nil, err
+		}
+		total = total + pagesize
+		page++
+		scripts = append(scripts, response.Result...)
+		if total-response.Count >= 0 || len(response.Result) == 0 {
+			break
+		}
+	}
+
+	return scripts, nil
+}
+
+func (cli *Client) getComplianceScripts(page, pagesize int) (*ComplianceScriptList, error) {
+	var err error
+	var response ComplianceScriptList
+	request := cli.gorequest
+	apiPath := fmt.Sprintf("/api/v2/image_assurance/user_scripts?page=%v&pagesize=%v", page, pagesize)
+	err = cli.limiter.Wait(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	events, body, errs := request.Clone().Set("Authorization", "Bearer "+cli.token).Get(cli.url + apiPath).End()
+	if errs != nil {
+		return nil, errors.Wrap(getMergedError(errs), "failed getting custom compliance scripts")
+	}
+	if events.StatusCode == 200 {
+		err = json.Unmarshal([]byte(body), &response)
+		if err != nil {
+			log.Printf("Error unmarshaling response body")
+			return nil, errors.Wrap(err, fmt.Sprintf("couldn't unmarshal get custom compliance scripts response. Body: %v", body))
+		}
+	} else {
+		var errorReponse ErrorResponse
+		err = json.Unmarshal([]byte(body), &errorReponse)
+		if err != nil {
+			log.Println("failed to unmarshal error response")
+			return nil, fmt.Errorf("failed getting custom compliance scripts. Status: %v, Response: %v", events.StatusCode, body)
+		}
+
+		return nil, fmt.Errorf("failed getting custom compliance scripts. Status: %v, error message: %v", events.StatusCode, errorReponse.Message)
+	}
+
+	return &response, nil
+}
+
+// CreateComplianceScript creates a custom compliance script and sets the ID the console assigned to it
+func (cli *Client) CreateComplianceScript(script *ComplianceScript) error {
+	payload, err := json.Marshal(script)
+	if err != nil {
+		return err
+	}
+	request := cli.gorequest
+	apiPath := "/api/v2/image_assurance/user_scripts"
+	err = cli.limiter.Wait(context.Background())
+	if err != nil {
+		return err
+	}
+	resp, body, errs := request.Clone().Set("Authorization", "Bearer "+cli.token).Post(cli.url + apiPath).Send(string(payload)).End()
+	if errs != nil {
+		return errors.Wrap(getMergedError(errs), "failed creating custom compliance script")
+	}
+	if resp.StatusCode != 200 && resp.StatusCode != 201 && resp.StatusCode != 204 {
+		var errorResponse ErrorResponse
+		err = json.Unmarshal([]byte(body), &errorResponse)
+		if err != nil {
+			log.Printf("Failed to Unmarshal response Body to ErrorResponse. Body: %v", body)
+			return fmt.Errorf("failed creating custom compliance script %v. Status: %v, Response: %v", script.Name, resp.StatusCode, body)
+		}
+		return fmt.Errorf("failed creating custom compliance script. status: %v. error message: %v", resp.Status, errorResponse.Message)
+	}
+
+	var created ComplianceScript
+	if err := json.Unmarshal([]byte(body), &created); err == nil && created.ScriptID != "" {
+		script.ScriptID = created.ScriptID
+		return nil
+	}
+
+	// the console does not always return the created script, look it up by name
+	scripts, err := cli.GetComplianceScripts()
+	if err != nil {
+		return err
+	}
+	for _, s := range scripts {
+		if s.Name == script.Name {
+			script.ScriptID = s.ScriptID
+			return nil
+		}
+	}
+	return fmt.Errorf("custom compliance script %s was created but could not be found", script.Name)
+}
+
+// UpdateComplianceScript updates an existing custom compliance script
+func (cli *Client) UpdateComplianceScript(script *ComplianceScript) error {
+	payload, err := json.Marshal(script)
+	if err != nil {
+		return err
+	}
+	request := cli.gorequest
+	apiPath := fmt.Sprintf("/api/v2/image_assurance/user_scripts/%s", script.ScriptID)
+	err = cli.limiter.Wait(context.Background())
+	if err != nil {
+		return err
+	}
+	resp, body, errs := request.Clone().Set("Authorization", "Bearer "+cli.token).Put(cli.url + apiPath).Send(string(payload)).End()
+	if errs != nil {
+		return errors.Wrap(getMergedError(errs), "failed modifying custom compliance script")
+	}
+	if resp.StatusCode != 200 && resp.StatusCode != 201 && resp.StatusCode != 204 {
+		var errorResponse ErrorResponse
+		err = json.Unmarshal([]byte(body), &errorResponse)
+		if err != nil {
+			log.Printf("Failed to Unmarshal response Body to ErrorResponse. Body: %v", body)
+			return fmt.Errorf("failed modifying custom compliance script %v. Status: %v, Response: %v", script.ScriptID, resp.StatusCode, body)
+		}
+		return fmt.Errorf("failed modifying custom compliance script. status: %v. error message: %v", resp.Status, errorResponse.Message)
+	}
+	return nil
+}
+
+// DeleteComplianceScript removes a custom compliance script
+func (cli *Client) DeleteComplianceScript(id string) error {
+	request := cli.gorequest
+	apiPath := fmt.Sprintf("/api/v2/image_assurance/user_scripts/%s", id)
+	err := cli.limiter.Wait(context.Background())
+	if err != nil {
+		return err
+	}
+	resp, body, errs := request.Clone().Set("Authorization", "Bearer "+cli.token).Delete(cli.url + apiPath).End()
+	if errs != nil {
+		return errors.Wrap(getMergedError(errs), "failed deleting custom compliance script")
+	}
+	if resp.StatusCode != 200 && resp.StatusCode != 204 {
+		var errorResponse ErrorResponse
+		err = json.Unmarshal([]byte(body), &errorResponse)
+		if err != nil {
+			log.Printf("Failed to Unmarshal response Body to ErrorResponse. Body: %v", body)
+			return fmt.Errorf("failed deleting custom compliance script %v. Status: %v, Response: %v", id, resp.StatusCode, body)
+		}
+		return fmt.Errorf("failed deleting custom compliance script, status: %v. error message: %v", resp.Status, errorResponse.Message)
+	}
+	return nil
+}
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -96,16 +98,25 @@ type AssuranceResults struct {
 	ChecksPerformed []ChecksPerformed `json:"checks_performed"`
 }
 
+type ImageList struct {
+	Count    int     `json:"count"`
+	Page     int     `json:"page"`
+	Pagesize int     `json:"pagesize"`
+	Result   []Image `json:"result"`
+}
+
 // CreateImage creates an Khulnasoft Image
 func (cli *Client) CreateImage(image *Image) error {
-	images := struct {
+	return cli.CreateImages([]Image{*image})
+}
+
+// CreateImages registers several Khulnasoft Images in a single request
+func (cli *Client) CreateImages(images []Image) error {
+	payload, err := json.Marshal(struct {
 		Images []Image `json:"images"`
 	}{
-		Images: []Image{
-			*image,
-		},
-	}
-	payload, err := json.Marshal(images)
+		Images: images,
+	})
 	if err != nil {
 		return err
 	}
@@ -125,14 +136,22 @@ func (cli *Client) CreateImage(image *Image) error {
 		err = json.Unmarshal([]byte(body), &errorResponse)
 		if err != nil {
 			log.Printf("Failed to Unmarshal response Body to ErrorResponse. Body: %v", body)
-			return fmt.Errorf("failed creating image with name %v/%v:%v. Status: %v, Response: %v", image.Registry, image.Repository, image.Tag, resp.StatusCode, body)
+			return fmt.Errorf("failed creating images %v. Status: %v, Response: %v", imageNames(images), resp.StatusCode, body)
 		}
-		return fmt.Errorf("failed creating image. status: %v. error message: %v", resp.Status, errorResponse.Message)
+		return fmt.Errorf("failed creating images. status: %v. error message: %v", resp.Status, errorResponse.Message)
 	}
 
 	return nil
 }
 
+func imageNames(images []Image) string {
+	names := make([]string, len(images))
+	for i, image := range images {
+		names[i] = fmt.Sprintf("%v/%v:%v", image.Registry, image.Repository, image.Tag)
+	}
+	return strings.Join(names, ", ")
+}
+
 // GetImage gets an Khulnasoft image by registry/name/tag
 func (cli *Client) GetImage(imageUrl string) (*Image, error) {
 
@@ -168,6 +187,65 @@ func (cli *Client) GetImage(imageUrl string) (*Image, error) {
 	return &response, nil
 }
 
+// GetImages gets all the registered images, optionally limited to a single registry
+func (cli *Client) GetImages(registry string) ([]Image, error) {
+	var images []Image
+
+	var page = 1
+	var pagesize = 100
+	var total = 0
+	for {
+		response, err := cli.getImages(registry, page, pagesize)
+		if err != nil {
+			return nil, err
+		}
+		total = total + pagesize
+		page++
+		images = append(images, response.Result...)
+		if total-response.Count >= 0 || len(response.Result) == 0 {
+			break
+		}
+	}
+
+	return images, nil
+}
+
+func (cli *Client) getImages(registry string, page, pagesize int) (*ImageList, error) {
+	var err error
+	var response ImageList
+	request := cli.gorequest
+	apiPath := fmt.Sprintf("/api/v2/images?page=%v&pagesize=%v&include_totals=true", page, pagesize)
+	if registry != "" {
+		apiPath = apiPath + "&registry=" + url.QueryEscape(registry)
+	}
+	err = cli.limiter.Wait(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	events, body, errs := request.Clone().Set("Authorization", "Bearer "+cli.token).Get(cli.url + apiPath).End()
+	if errs != nil {
+		return nil, errors.Wrap(getMergedError(errs), "failed getting images")
+	}
+	if events.StatusCode == 200 {
+		err = json.Unmarshal([]byte(body), &response)
+		if err != nil {
+			log.Printf("Error unmarshaling response body")
+			return nil, errors.Wrap(err, fmt.Sprintf("couldn't unmarshal get images response. Body: %v", body))
+		}
+	} else {
+		var errorReponse ErrorResponse
+		err = json.Unmarshal([]byte(body), &errorReponse)
+		if err != nil {
+			log.Println("failed to unmarshal error response")
+			return nil, fmt.Errorf("failed getting images. Status: %v, Response: %v", events.StatusCode, body)
+		}
+
+		return nil, fmt.Errorf("failed getting images. Status: %v, error message: %v", events.StatusCode, errorReponse.Message)
+	}
+
+	return &response, nil
+}
+
 // RescanImage rescans an existing image
 func (cli *Client) RescanImage(image *Image, fullRescan bool) error {
 	images := struct {
@@ -233,6 +311,34 @@ func (cli *Client) WaitUntilScanCompleted(image *Image) error {
 	return nil
 }
 
+// WaitUntilScansCompleted waits until the scan of every image completes, checking all the images still scanning
+// in each round, or until the context is done
+func (cli *Client) WaitUntilScansCompleted(ctx context.Context, images []Image) error {
+	pending := images
+	for len(pending) > 0 {
+		var scanning []Image
+		for _, image := range pending {
+			img, err := cli.GetImage(fmt.Sprintf("%v/%v/%v", image.Registry, image.Repository, image.Tag))
+			if err != nil {
+				return err
+			}
+			if img.ScanStatus == "pending" || img.ScanStatus == "in_progress" {
+				scanning = append(scanning, image)
+			}
+		}
+		if pending = scanning; len(pending) == 0 {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return errors.Wrapf(ctx.Err(), "%d images are still scanning", len(pending))
+		case <-time.After(2 * time.Second):
+		}
+	}
+
+	return nil
+}
+
 // DeleteImage removes a Khulnasoft Image
 func (cli *Client) DeleteImage(image *Image) error {
 	registry := image.Registry
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"net/url"
 
 	"github.com/pkg/errors"
 )
@@ -192,3 +193,46 @@ func (cli *Client) DeleteKhulnasoftLabel(name string) error {
 	}
 	return nil
 }
+
+// KhulnasoftLabelAssignment identifies an object a Khulnasoft label is attached to
+type KhulnasoftLabelAssignment struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// AttachKhulnasoftLabel attaches a Khulnasoft label to an object
+func (cli *Client) AttachKhulnasoftLabel(name string, assignment *KhulnasoftLabelAssignment) error {
+	return cli.changeKhulnasoftLabelAssignment(name, "attach", assignment)
+}
+
+// DetachKhulnasoftLabel detaches a Khulnasoft label from an object
+func (cli *Client) DetachKhulnasoftLabel(name string, assignment *KhulnasoftLabelAssignment) error {
+	return cli.changeKhulnasoftLabelAssignment(name, "detach", assignment)
+}
+
+func (cli *Client) changeKhulnasoftLabelAssignment(name, action string, assignment *KhulnasoftLabelAssignment) error {
+	payload, err := json.Marshal(assignment)
+	if err != nil {
+		return err
+	}
+	request := cli.gorequest
+	apiPath := fmt.Sprintf("/api/v1/settings/labels/%s/%s", url.PathEscape(name), action)
+	err = cli.limiter.Wait(context.Background())
+	if err != nil {
+		return err
+	}
+	resp, body, errs := request.Clone().Set("Authorization", "Bearer "+cli.token).Post(cli.url + apiPath).Send(string(payload)).End()
+	if errs != nil {
+		return errors.Wrap(getMergedError(errs), fmt.Sprintf("failed to %s Khulnasoft label", action))
+	}
+	if resp.StatusCode != 200 && resp.StatusCode != 201 && resp.StatusCode != 204 {
+		var errorResponse ErrorResponse
+		err = json.Unmarshal([]byte(body), &errorResponse)
+		if err != nil {
+			log.Printf("Failed to Unmarshal response Body to ErrorResponse. Body: %v. error: %v", body, err)
+			return fmt.Errorf("failed to %s Khulnasoft label %s on %s %s. Status: %v, Response: %v", action, name, assignment.Type, assignment.ID, resp.StatusCode, body)
+		}
+		return fmt.Errorf("failed to %s Khulnasoft label %s on %s %s. status: %v. error message: %v", action, name, assignment.Type, assignment.ID, resp.Status, errorResponse.Message)
+	}
+	return nil
+}
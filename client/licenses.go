@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+type LicensesList struct {
+	Count    int       `json:"count"`
+	Page     int       `json:"page"`
+	Pagesize int       `json:"pagesize"`
+	Result   []License `json:"result"`
+}
+
+// License is a license found in the packages of the scanned images
+type License struct {
+	License       string `json:"license"`
+	ImagesCount   int    `json:"images_count"`
+	PackagesCount int    `json:"packages_count"`
+}
+
+// GetLicenses gets the licenses found in the packages of the scanned images, optionally of a single registry
+func (cli *Client) GetLicenses(registry string) ([]License, error) {
+	var licenses []License
+
+	var page = 1
+	var pagesize = 100
+	var total = 0
+	for {
+		response, err := cli.getLicenses(registry, page, pagesize)
+		if err != nil {
+			return nil, err
+		}
+		total = total + pagesize
+		page++
+		licenses = append(licenses, response.Result...)
+		if total-response.Count >= 0 || len(response.Result) == 0 {
+			break
+		}
+	}
+
+	return licenses, nil
+}
+
+func (cli *Client) getLicenses(registry string, page, pagesize int) (*LicensesList, error) {
+	var err error
+	var response LicensesList
+	request := cli.gorequest
+	apiPath := fmt.Sprintf("/api/v2/risks/licenses?page=%v&pagesize=%v", page, pagesize)
+	if registry != "" {
+		apiPath = apiPath + "&registry_name=" + url.QueryEscape(registry)
+	}
+	err = cli.limiter.Wait(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	events, body, errs := request.Clone().Set("Authorization", "Bearer "+cli.token).Get(cli.url + apiPath).End()
+	if errs != nil {
+		return nil, errors.Wrap(getMergedError(errs), "failed getting licenses")
+	}
+	if events.StatusCode == 200 {
+		err = json.Unmarshal([]byte(body), &response)
+		if err != nil {
+			log.Printf("Error unmarshaling response body")
+			return nil, errors.Wrap(err, fmt.Sprintf("couldn't unmarshal get licenses response. Body: %v", body))
+		}
+	} else {
+		var errorReponse ErrorResponse
+		err = json.Unmarshal([]byte(body), &errorReponse)
+		if err != nil {
+			log.Println("failed to unmarshal error response")
+			return nil, fmt.Errorf("failed getting licenses. Status: %v, Response: %v", events.StatusCode, body)
+		}
+
+		return nil, fmt.Errorf("failed getting licenses. Status: %v, error message: %v", events.StatusCode, errorReponse.Message)
+	}
+
+	return &response, nil
+}
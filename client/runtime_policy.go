@@ -434,6 +434,70 @@ func (cli *Client) GetRuntimePolicy(name string) (*RuntimePolicy, error) {
 	return &response, nil
 }
 
+// RuntimePolicyList is a page of runtime policies
+type RuntimePolicyList struct {
+	Count    int             `json:"count"`
+	Page     int             `json:"page"`
+	Pagesize int             `json:"pagesize"`
+	Result   []RuntimePolicy `json:"result"`
+}
+
+// GetRuntimePolicies gets all the runtime policies of every runtime type
+func (cli *Client) GetRuntimePolicies() ([]RuntimePolicy, error) {
+	var policies []RuntimePolicy
+
+	var page = 1
+	var pagesize = 100
+	var total = 0
+	for {
+		response, err := cli.getRuntimePolicies(page, pagesize)
+		if err != nil {
+			return nil, err
+		}
+		total = total + pagesize
+		page++
+		policies = append(policies, response.Result...)
+		if total-response.Count >= 0 || len(response.Result) == 0 {
+			break
+		}
+	}
+
+	return policies, nil
+}
+
+func (cli *Client) getRuntimePolicies(page, pagesize int) (*RuntimePolicyList, error) {
+	var err error
+	var response RuntimePolicyList
+	request := cli.gorequest
+	apiPath := fmt.Sprintf("/api/v2/runtime_policies?page=%v&pagesize=%v", page, pagesize)
+	err = cli.limiter.Wait(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	events, body, errs := request.Clone().Set("Authorization", "Bearer "+cli.token).Get(cli.url + apiPath).End()
+	if errs != nil {
+		return nil, errors.Wrap(getMergedError(errs), "failed getting runtime policies")
+	}
+	if events.StatusCode == 200 {
+		err = json.Unmarshal([]byte(body), &response)
+		if err != nil {
+			log.Printf("Error unmarshaling response body")
+			return nil, errors.Wrap(err, fmt.Sprintf("couldn't unmarshal get runtime policies response. Body: %v", body))
+		}
+	} else {
+		var errorReponse ErrorResponse
+		err = json.Unmarshal([]byte(body), &errorReponse)
+		if err != nil {
+			log.Println("failed to unmarshal error response")
+			return nil, fmt.Errorf("failed getting runtime policies. Status: %v, Response: %v", events.StatusCode, body)
+		}
+
+		return nil, fmt.Errorf("failed getting runtime policies. Status: %v, error message: %v", events.StatusCode, errorReponse.Message)
+	}
+
+	return &response, nil
+}
+
 // UpdateRuntimePolicy updates an existing runtime policy policy
 func (cli *Client) UpdateRuntimePolicy(runtimePolicy *RuntimePolicy) error {
 	payload, err := json.Marshal(runtimePolicy)
@@ -491,3 +555,73 @@ func (cli *Client) DeleteRuntimePolicy(name string) error {
 	}
 	return nil
 }
+
+// RuntimePolicyOrder is the order in which the console evaluates the runtime policies of a runtime type, highest
+// precedence first
+type RuntimePolicyOrder struct {
+	RuntimeType string   `json:"runtime_type"`
+	Policies    []string `json:"policies"`
+}
+
+// GetRuntimePolicyOrder gets the evaluation order of the runtime policies of a runtime type
+func (cli *Client) GetRuntimePolicyOrder(runtimeType string) (*RuntimePolicyOrder, error) {
+	var err error
+	var response RuntimePolicyOrder
+	request := cli.gorequest
+	apiPath := fmt.Sprintf("/api/v2/runtime_policies_order/%v", runtimeType)
+	err = cli.limiter.Wait(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	events, body, errs := request.Clone().Set("Authorization", "Bearer "+cli.token).Get(cli.url + apiPath).End()
+	if errs != nil {
+		return nil, errors.Wrap(getMergedError(errs), "failed getting runtime policy order of runtime type "+runtimeType)
+	}
+	if events.StatusCode == 200 {
+		err = json.Unmarshal([]byte(body), &response)
+		if err != nil {
+			log.Printf("Error unmarshaling response body")
+			return nil, errors.Wrap(err, fmt.Sprintf("couldn't unmarshal get runtime policy order response. Body: %v", body))
+		}
+	} else {
+		var errorReponse ErrorResponse
+		err = json.Unmarshal([]byte(body), &errorReponse)
+		if err != nil {
+			log.Println("failed to unmarshal error response")
+			return nil, fmt.Errorf("failed getting runtime policy order of runtime type %v. Status: %v, Response: %v", runtimeType, events.StatusCode, body)
+		}
+
+		return nil, fmt.Errorf("failed getting runtime policy order of runtime type %v. Status: %v, error message: %v", runtimeType, events.StatusCode, errorReponse.Message)
+	}
+
+	return &response, nil
+}
+
+// UpdateRuntimePolicyOrder sets the evaluation order of the runtime policies of a runtime type, which must list all
+// of them
+func (cli *Client) UpdateRuntimePolicyOrder(order *RuntimePolicyOrder) error {
+	payload, err := json.Marshal(order)
+	if err != nil {
+		return err
+	}
+	request := cli.gorequest
+	apiPath := fmt.Sprintf("/api/v2/runtime_policies_order/%s", order.RuntimeType)
+	err = cli.limiter.Wait(context.Background())
+	if err != nil {
+		return err
+	}
+	resp, body, errs := request.Clone().Set("Authorization", "Bearer "+cli.token).Put(cli.url + apiPath).Send(string(payload)).End()
+	if errs != nil {
+		return errors.Wrap(getMergedError(errs), "failed modifying runtime policy order")
+	}
+	if resp.StatusCode != 200 && resp.StatusCode != 201 && resp.StatusCode != 204 {
+		var errorResponse ErrorResponse
+		err = json.Unmarshal([]byte(body), &errorResponse)
+		if err != nil {
+			log.Printf("Failed to Unmarshal response Body to ErrorResponse. Body: %v", body)
+			return fmt.Errorf("failed modifying runtime policy order of runtime type %v. Status: %v, Response: %v", order.RuntimeType, resp.StatusCode, body)
+		}
+		return fmt.Errorf("failed modifying runtime policy order. status: %v. error message: %v", resp.Status, errorResponse.Message)
+	}
+	return nil
+}
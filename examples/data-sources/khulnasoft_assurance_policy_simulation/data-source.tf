@@ -0,0 +1,26 @@
+# Images a stricter policy would fail or block, before enforcing it
+data "khulnasoft_assurance_policy_simulation" "strict" {
+  name                  = "strict"
+  application_scopes    = ["Global"]
+  block_failed          = true
+  cvss_severity_enabled = true
+  cvss_severity         = "high"
+  maximum_score_enabled = true
+  maximum_score         = 7.5
+
+  image {
+    registry   = "Docker Hub"
+    repository = "library/nginx"
+    tag        = "1.25"
+  }
+
+  image {
+    registry   = "Docker Hub"
+    repository = "library/redis"
+    tag        = "7.2"
+  }
+}
+
+output "failing_images" {
+  value = data.khulnasoft_assurance_policy_simulation.strict.failed_images
+}
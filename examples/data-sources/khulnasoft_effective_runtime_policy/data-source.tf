@@ -0,0 +1,18 @@
+data "khulnasoft_effective_runtime_policy" "checkout" {
+  runtime_type = "container"
+  image        = "payments/checkout:2.4.1"
+  registry     = "Docker Hub"
+  cluster      = "prod-eu"
+  namespace    = "payments"
+  labels = {
+    app = "checkout"
+  }
+}
+
+output "checkout_runtime_policies" {
+  value = data.khulnasoft_effective_runtime_policy.checkout.policy_names
+}
+
+output "checkout_enforced_controls" {
+  value = [for control in data.khulnasoft_effective_runtime_policy.checkout.controls : control.name if control.enforced]
+}
@@ -0,0 +1,12 @@
+data "khulnasoft_images" "disallowed_critical" {
+  registry                     = "Docker Hub"
+  repository                   = "library/*"
+  scan_status                  = "finished"
+  disallowed                   = true
+  min_critical_vulnerabilities = 1
+  scanned_after                = "2024-01-01T00:00:00Z"
+}
+
+output "disallowed_critical_images" {
+  value = [for image in data.khulnasoft_images.disallowed_critical.images : image.id]
+}
@@ -0,0 +1,24 @@
+data "khulnasoft_kubernetes_controls" "critical_pod" {
+  severities = ["critical"]
+  kinds      = ["Pod"]
+}
+
+resource "khulnasoft_kubernetes_assurance_policy" "critical_pod" {
+  name                        = "critical-pod-controls"
+  application_scopes          = ["Global"]
+  kubernetes_controls_enabled = true
+
+  dynamic "kubernetes_controls" {
+    for_each = { for control in data.khulnasoft_kubernetes_controls.critical_pod.controls : tostring(control.script_id) => control }
+    content {
+      script_id   = kubernetes_controls.value.script_id
+      name        = kubernetes_controls.value.name
+      description = kubernetes_controls.value.description
+      severity    = kubernetes_controls.value.severity
+      kind        = kubernetes_controls.value.kind
+      ootb        = kubernetes_controls.value.ootb
+      avd_id      = kubernetes_controls.value.avd_id
+      enabled     = true
+    }
+  }
+}
@@ -0,0 +1,19 @@
+data "khulnasoft_licenses" "uncategorized" {
+  categories = ["uncategorized"]
+}
+
+# licenses found in the scanned images to review with the legal team
+output "uncategorized_licenses" {
+  value = [for license in data.khulnasoft_licenses.uncategorized.licenses : license.license]
+}
+
+resource "khulnasoft_image_assurance_policy" "licenses" {
+  name                            = "licenses"
+  application_scopes              = ["Global"]
+  blacklisted_licenses_enabled    = true
+  blacklisted_licenses_categories = ["proprietary"]
+  blacklisted_licenses            = ["AGPL-3.0-only", "LicenseRef-Acme-EULA"]
+  whitelisted_licenses_enabled    = true
+  whitelisted_licenses_categories = ["permissive"]
+  whitelisted_licenses            = ["GPL-2.0-only WITH Classpath-exception-2.0"]
+}
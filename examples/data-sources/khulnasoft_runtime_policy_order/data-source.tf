@@ -0,0 +1,7 @@
+data "khulnasoft_runtime_policy_order" "host" {
+  runtime_type = "host"
+}
+
+output "host_runtime_policy_evaluation_order" {
+  value = data.khulnasoft_runtime_policy_order.host.evaluation_order
+}
@@ -0,0 +1,7 @@
+data "khulnasoft_vmware_assurance_policy" "default" {
+  name = "Default"
+}
+
+output "vmware_assurance_policy_enforce" {
+  value = data.khulnasoft_vmware_assurance_policy.default.enforce
+}
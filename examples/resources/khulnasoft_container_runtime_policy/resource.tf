@@ -96,4 +96,25 @@ resource "khulnasoft_container_runtime_policy" "container_runtime_policy" {
     "blocked",
     "vol"
   ]
-}
\ No newline at end of file
+}
+resource "khulnasoft_container_runtime_policy" "staged_enforcement" {
+  name        = "staged_enforcement"
+  description = "Audit only until the canary stage, then enforce on production"
+  enabled     = true
+
+  application_scopes = [
+    "Global",
+  ]
+
+  enforcement_rollout {
+    stage {
+      name               = "canary"
+      starts_at          = "2026-11-01T00:00:00Z"
+      application_scopes = ["canary"]
+    }
+    stage {
+      name      = "production"
+      starts_at = "2026-12-01T00:00:00Z"
+    }
+  }
+}
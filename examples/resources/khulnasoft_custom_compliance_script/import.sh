@@ -0,0 +1,2 @@
+# Custom compliance scripts can be imported using the script ID
+terraform import khulnasoft_custom_compliance_script.sshd_root_login 6f1d2b6e-6a1b-4c2e-9d6f-0c8a1b2c3d4e
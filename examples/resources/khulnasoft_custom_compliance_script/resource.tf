@@ -0,0 +1,17 @@
+resource "khulnasoft_custom_compliance_script" "sshd_root_login" {
+  name         = "sshd-root-login-disabled"
+  description  = "sshd does not allow root login"
+  engine       = "bash"
+  severity     = "high"
+  snippet_file = "${path.module}/scripts/sshd_root_login.sh"
+}
+
+resource "khulnasoft_host_assurance_policy" "hosts" {
+  name                  = "hosts"
+  application_scopes    = ["Global"]
+  custom_checks_enabled = true
+
+  custom_checks {
+    script_id = khulnasoft_custom_compliance_script.sshd_root_login.script_id
+  }
+}
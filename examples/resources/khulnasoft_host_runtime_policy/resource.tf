@@ -57,4 +57,10 @@ resource "khulnasoft_host_runtime_policy" "host_runtime_policy" {
   monitor_system_time_changes  = true
   monitor_windows_services     = true
   monitor_system_log_integrity = true
-}
\ No newline at end of file
+}
+# Start from an existing runtime policy and only override a few attributes
+resource "khulnasoft_host_runtime_policy" "from_existing" {
+  name        = "from_existing"
+  base_policy = khulnasoft_host_runtime_policy.host_runtime_policy.name
+  enforce     = true
+}
@@ -4,4 +4,15 @@ resource "khulnasoft_image" "example_khulnasoft_image" {
   repository = "ExampleRepository"
   tag        = "ExampleImageTag"
 
-}
\ No newline at end of file
+}
+
+resource "khulnasoft_image" "example_khulnasoft_image_exception" {
+  registry   = "ExampleRegistry"
+  repository = "ExampleRepository"
+  tag        = "ExampleExceptionTag"
+
+  // Temporarily allow the image, the permission is reverted by the first apply after 30 days
+  allow_image                     = true
+  permission_modification_comment = "Exception until the base image is patched"
+  permission_expires_in           = "30d"
+}
@@ -16,4 +16,11 @@ resource "khulnasoft_image_assurance_policy" "test_image_policy" {
   disallow_malware      = true
   scan_sensitive_data   = true
 
-}
\ No newline at end of file
+}
+# Start from the console default policy and only override a few attributes
+resource "khulnasoft_image_assurance_policy" "from_default" {
+  name               = "from_default"
+  base_policy        = "Default"
+  application_scopes = ["Global"]
+  cvss_severity      = "high"
+}
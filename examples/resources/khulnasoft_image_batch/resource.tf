@@ -0,0 +1,18 @@
+locals {
+  services = ["api", "web", "worker"]
+}
+
+resource "khulnasoft_image_batch" "monorepo" {
+  dynamic "image" {
+    for_each = toset(local.services)
+    content {
+      registry   = "ExampleRegistry"
+      repository = "monorepo/${image.value}"
+      tag        = "1.4.0"
+    }
+  }
+}
+
+output "disallowed_images" {
+  value = [for result in khulnasoft_image_batch.monorepo.results : result.id if result.disallowed]
+}
@@ -0,0 +1,20 @@
+resource "khulnasoft_kubernetes_runtime_policy" "kubernetes_runtime_policy" {
+  name        = "kubernetes_runtime_policy"
+  description = "kubernetes_runtime_policy"
+
+  application_scopes = [
+    "Global",
+  ]
+  enabled                  = true
+  enforce                  = false
+  block_non_k8s_containers = true
+
+  failed_kubernetes_checks {
+    enabled       = true
+    failed_checks = ["KSV001"]
+  }
+  linux_capabilities {
+    enabled                   = true
+    remove_linux_capabilities = ["NET_RAW"]
+  }
+}
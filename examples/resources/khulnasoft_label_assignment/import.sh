@@ -0,0 +1,2 @@
+# Label assignments can be imported using label,object_type,object_id
+terraform import khulnasoft_label_assignment.app_production "production,image,Docker Hub/library/nginx:1.25"
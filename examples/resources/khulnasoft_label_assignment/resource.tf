@@ -0,0 +1,16 @@
+resource "khulnasoft_khulnasoft_label" "production" {
+  name        = "production"
+  description = "Images approved for production"
+}
+
+resource "khulnasoft_image" "app" {
+  registry   = "Docker Hub"
+  repository = "library/nginx"
+  tag        = "1.25"
+}
+
+resource "khulnasoft_label_assignment" "app_production" {
+  label       = khulnasoft_khulnasoft_label.production.name
+  object_type = "image"
+  object_id   = khulnasoft_image.app.id
+}
@@ -0,0 +1,27 @@
+# The security team manages the policy and ignores the allow-lists the application teams add entries to
+resource "khulnasoft_container_runtime_policy" "production" {
+  name               = "production"
+  description        = "Runtime policy of the production clusters"
+  application_scopes = ["Global"]
+  enabled            = true
+  enforce            = true
+
+  drift_prevention {
+    enabled                  = true
+    exec_lockdown            = true
+    exec_lockdown_white_list = ["/usr/bin/curl"]
+  }
+
+  lifecycle {
+    ignore_changes = [drift_prevention, reverse_shell]
+  }
+}
+
+# The payments team owns its exceptions, which are merged into the policy
+resource "khulnasoft_runtime_policy_exception" "payments" {
+  policy_name = khulnasoft_container_runtime_policy.production.name
+  owner       = "payments"
+
+  exec_lockdown_white_list      = ["/usr/local/bin/payments-worker"]
+  reverse_shell_proc_white_list = ["/usr/local/bin/payments-debug"]
+}
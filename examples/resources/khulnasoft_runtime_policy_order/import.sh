@@ -0,0 +1,2 @@
+# Runtime policy orders can be imported using the runtime type
+terraform import khulnasoft_runtime_policy_order.container container
@@ -0,0 +1,12 @@
+# The production policy takes precedence over the baseline policy, the other container runtime policies are evaluated after them
+resource "khulnasoft_runtime_policy_order" "container" {
+  runtime_type = "container"
+  policies = [
+    khulnasoft_container_runtime_policy.production.name,
+    khulnasoft_container_runtime_policy.baseline.name,
+  ]
+}
+
+output "container_runtime_policy_evaluation_order" {
+  value = khulnasoft_runtime_policy_order.container.evaluation_order
+}
@@ -0,0 +1,27 @@
+resource "khulnasoft_trusted_base_images" "golden" {
+  name        = "golden-images"
+  description = "Base images maintained by the platform team"
+
+  trusted_base_images {
+    registry  = "Docker Hub"
+    imagename = "library/alpine"
+  }
+
+  trusted_base_images {
+    registry  = "Docker Hub"
+    imagename = "library/debian"
+  }
+}
+
+resource "khulnasoft_image_assurance_policy" "production" {
+  name                        = "production"
+  application_scopes          = ["Global"]
+  trusted_base_images_enabled = true
+  trusted_base_images_sets    = [khulnasoft_trusted_base_images.golden.reference]
+
+  # images trusted by this policy only
+  trusted_base_images {
+    registry  = "Docker Hub"
+    imagename = "library/nginx"
+  }
+}
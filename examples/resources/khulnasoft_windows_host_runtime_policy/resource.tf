@@ -0,0 +1,27 @@
+resource "khulnasoft_windows_host_runtime_policy" "windows_host_runtime_policy" {
+  name        = "windows_host_runtime_policy"
+  description = "windows_host_runtime_policy"
+
+  application_scopes = [
+    "Global",
+  ]
+  enabled = true
+  enforce = false
+
+  registry_access_monitoring {
+    enabled                   = true
+    monitored_registry_paths  = ["HKEY_LOCAL_MACHINE\\SOFTWARE"]
+    monitored_registry_create = true
+    monitored_registry_modify = true
+    monitored_registry_delete = true
+  }
+  readonly_registry {
+    enabled                 = true
+    readonly_registry_paths = ["HKEY_LOCAL_MACHINE\\SYSTEM"]
+  }
+  system_integrity_protection {
+    enabled                     = true
+    audit_systemtime_change     = true
+    windows_services_monitoring = true
+  }
+}
@@ -0,0 +1,1351 @@
+package khulnasoft
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// assurancePolicyField is an attribute of the assurance policies, declared by the resources and data sources of the
+// assurance types it applies to. The resources of the other types reject it as an unsupported argument, unless they
+// declared it before, in which case they keep declaring it as deprecated.
+type assurancePolicyField struct {
+	name string
+	// types are the assurance types the attribute applies to, all of them when empty
+	types []client.AssuranceType
+	// deprecatedElsewhere attributes are declared as deprecated by the assurance types they don't apply to
+	deprecatedElsewhere bool
+	// input attributes only configure the resources, they are not exposed by the data sources
+	input bool
+	// schema is the attribute of the resources, nil for the attributes only exposed by the data sources
+	schema func(assuranceType client.AssuranceType) *schema.Schema
+	// dataSchema is the attribute of the data sources when it is not the computed attribute of the resources
+	dataSchema func(assuranceType client.AssuranceType) *schema.Schema
+	// expand is called with the value of the attribute when it is set, nil for the attributes that are never sent
+	expand func(d *schema.ResourceData, v interface{}, iap *client.AssurancePolicy)
+	// flatten reads the attribute from the policy, nil for the attributes that are not read from the console
+	flatten func(d *schema.ResourceData, iap *client.AssurancePolicy)
+}
+
+// assurancePolicyFields are the attributes of the assurance policies. Adding a client.AssurancePolicy field here makes
+// it available to the resource and the data source of every assurance type it applies to. The fields are expanded in
+// this order, so those overriding others, such as scope_conditions, come after them.
+var assurancePolicyFields = []assurancePolicyField{
+	{
+		name: "assurance_type",
+		schema: func(client.AssuranceType) *schema.Schema {
+			return &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "What type of assurance policy is described.",
+				Optional:    true,
+				Computed:    true,
+			}
+		},
+		flatten: func(d *schema.ResourceData, iap *client.AssurancePolicy) {
+			d.Set("assurance_type", iap.AssuranceType)
+		},
+	},
+	assurancePolicyString("author", "Name of user account that created the policy.", func(iap *client.AssurancePolicy) *string { return &iap.Author }).
+		with(computed),
+	assurancePolicyString("registry", "", func(iap *client.AssurancePolicy) *string { return &iap.Registry }),
+	assurancePolicyBool("cvss_severity_enabled", "Indicates if the cvss severity is scanned.", func(iap *client.AssurancePolicy) *bool { return &iap.CvssSeverityEnabled }),
+	assurancePolicyString("cvss_severity", "Identifier of the cvss severity. Only applied if `cvss_severity_enabled` is set to `true`. Valid options: `critical`, `high`, `medium`, `low`.", func(iap *client.AssurancePolicy) *string { return &iap.CvssSeverity }),
+	assurancePolicyBool("cvss_severity_exclude_no_fix", "Indicates that policy should ignore cvss cases that do not have a known fix.", func(iap *client.AssurancePolicy) *bool { return &iap.CvssSeverityExcludeNoFix }),
+	assurancePolicyBool("custom_severity_enabled", "", func(iap *client.AssurancePolicy) *bool { return &iap.CustomSeverityEnabled }),
+	assurancePolicyBool("maximum_score_enabled", "Indicates if exceeding the maximum score is scanned.", func(iap *client.AssurancePolicy) *bool { return &iap.MaximumScoreEnabled }),
+	assurancePolicyFloat("maximum_score", "Value of allowed maximum score.", func(iap *client.AssurancePolicy) *float64 { return &iap.MaximumScore }).
+		with(func(s *schema.Schema, assuranceType client.AssuranceType) {
+			s.ValidateFunc = validation.FloatBetween(0, 10)
+		}),
+	assurancePolicyBool("control_exclude_no_fix", "", func(iap *client.AssurancePolicy) *bool { return &iap.ControlExcludeNoFix }),
+	assurancePolicyBool("custom_checks_enabled", "Indicates if scanning should include custom checks.", func(iap *client.AssurancePolicy) *bool { return &iap.CustomChecksEnabled }),
+	assurancePolicyBool("scap_enabled", "Indicates if scanning should include scap.", func(iap *client.AssurancePolicy) *bool { return &iap.ScapEnabled }),
+	assurancePolicyBool("cves_black_list_enabled", "Indicates if CVEs blacklist is relevant.", func(iap *client.AssurancePolicy) *bool { return &iap.CvesBlackListEnabled }),
+	assurancePolicyBool("packages_black_list_enabled", "Indicates if packages blacklist is relevant.", func(iap *client.AssurancePolicy) *bool { return &iap.PackagesBlackListEnabled }),
+	assurancePolicyBool("packages_white_list_enabled", "Indicates if packages whitelist is relevant.", func(iap *client.AssurancePolicy) *bool { return &iap.PackagesWhiteListEnabled }),
+	assurancePolicyBool("only_none_root_users", "Indicates if raise a warning for images that should only be run as root.", func(iap *client.AssurancePolicy) *bool { return &iap.OnlyNoneRootUsers }),
+	assurancePolicyBool("trusted_base_images_enabled", "Indicates if list of trusted base images is relevant.", func(iap *client.AssurancePolicy) *bool { return &iap.TrustedBaseImagesEnabled }).
+		deprecatedExcept(client.AssuranceTypeImage),
+	assurancePolicyBool("scan_sensitive_data", "Indicates if scan should include sensitive data in the image.", func(iap *client.AssurancePolicy) *bool { return &iap.ScanSensitiveData }),
+	assurancePolicyBool("audit_on_failure", "Indicates if auditing for failures.", func(iap *client.AssurancePolicy) *bool { return &iap.AuditOnFailure }).
+		with(defaultValue(true)),
+	assurancePolicyBool("fail_cicd", "Indicates if cicd failures will fail the image.", func(iap *client.AssurancePolicy) *bool { return &iap.FailCicd }).
+		with(func(s *schema.Schema, assuranceType client.AssuranceType) {
+			// Kubernetes resources are not built by a pipeline
+			s.Default = assuranceType != client.AssuranceTypeKubernetes
+		}),
+	assurancePolicyBool("block_failed", "Indicates if failed images are blocked.", func(iap *client.AssurancePolicy) *bool { return &iap.BlockFailed }).
+		with(defaultValue(true)),
+	assurancePolicyBool("disallow_malware", "Indicates if malware should block the image.", func(iap *client.AssurancePolicy) *bool { return &iap.DisallowMalware }),
+	assurancePolicyList("monitored_malware_paths", "", func(iap *client.AssurancePolicy) *[]interface{} { return &iap.MonitoredMalwarePaths }),
+	assurancePolicyList("exceptional_monitored_malware_paths", "", func(iap *client.AssurancePolicy) *[]interface{} { return &iap.ExceptionalMonitoredMalwarePaths }),
+	assurancePolicyBool("blacklisted_licenses_enabled", "Indicates if license blacklist is relevant.", func(iap *client.AssurancePolicy) *bool { return &iap.BlacklistedLicensesEnabled }),
+	assurancePolicyLicenses("blacklisted_licenses", func(iap *client.AssurancePolicy) *[]string { return &iap.BlacklistedLicenses }),
+	assurancePolicyLicenseCategories("blacklisted_licenses", func(iap *client.AssurancePolicy) *[]string { return &iap.BlacklistedLicenses }),
+	assurancePolicyBool("whitelisted_licenses_enabled", "Indicates if license whitelist is relevant.", func(iap *client.AssurancePolicy) *bool { return &iap.WhitelistedLicensesEnabled }),
+	assurancePolicyLicenses("whitelisted_licenses", func(iap *client.AssurancePolicy) *[]string { return &iap.WhitelistedLicenses }),
+	assurancePolicyLicenseCategories("whitelisted_licenses", func(iap *client.AssurancePolicy) *[]string { return &iap.WhitelistedLicenses }),
+	{
+		name:   "custom_checks",
+		schema: assurancePolicyCustomChecksSchema,
+		expand: func(d *schema.ResourceData, v interface{}, iap *client.AssurancePolicy) {
+			iap.CustomChecks = expandCustomChecks(v.([]interface{}))
+		},
+		flatten: func(d *schema.ResourceData, iap *client.AssurancePolicy) {
+			d.Set("custom_checks", flattenCustomChecks(iap.CustomChecks))
+		},
+	},
+	assurancePolicyList("scap_files", "List of SCAP user scripts for checks.", func(iap *client.AssurancePolicy) *[]interface{} { return &iap.ScapFiles }),
+	{
+		name:       "scope",
+		schema:     assurancePolicyScopeSchema,
+		dataSchema: assurancePolicyScopeDataSchema,
+		expand: func(d *schema.ResourceData, v interface{}, iap *client.AssurancePolicy) {
+			for _, scope := range v.(*schema.Set).List() {
+				if scope, ok := scope.(map[string]interface{}); ok {
+					iap.Scope = expandAssurancePolicyScope(scope)
+				}
+			}
+		},
+		flatten: func(d *schema.ResourceData, iap *client.AssurancePolicy) {
+			d.Set("scope", flatteniapscope(iap.Scope))
+		},
+	},
+	{
+		name:  "scope_conditions",
+		input: true,
+		schema: func(client.AssuranceType) *schema.Schema {
+			return scopeConditionsSchema("scope")
+		},
+		expand: func(d *schema.ResourceData, v interface{}, iap *client.AssurancePolicy) {
+			iap.Scope = assuranceScopeFromVariables(compileScopeConditions(v.([]interface{})))
+		},
+		flatten: func(d *schema.ResourceData, iap *client.AssurancePolicy) {
+			flattenScopeConditions(d, iap.Scope.Expression, scopeVariablesFromAssuranceScope(iap.Scope.Variables))
+		},
+	},
+	assurancePolicyAnyList("registries", "List of registries.", func(iap *client.AssurancePolicy) *interface{} { return &iap.Registries }),
+	assurancePolicyAnyList("labels", "List of labels.", func(iap *client.AssurancePolicy) *interface{} { return &iap.Labels }),
+	assurancePolicyAnyList("images", "List of images.", func(iap *client.AssurancePolicy) *interface{} { return &iap.Images }),
+	assurancePolicyStrings("cves_black_list", "List of CVEs blacklisted items.", func(iap *client.AssurancePolicy) *[]string { return &iap.CvesBlackList }),
+	assurancePolicyPackages("packages_black_list", "List of blacklisted images.", func(iap *client.AssurancePolicy) *[]client.ListPackages { return &iap.PackagesBlackList }),
+	assurancePolicyPackages("packages_white_list", "List of whitelisted images.", func(iap *client.AssurancePolicy) *[]client.ListPackages { return &iap.PackagesWhiteList }),
+	assurancePolicyPackageListFile("packages_black_list"),
+	assurancePolicyPackageListFile("packages_white_list"),
+	assurancePolicyAnyList("allowed_images", "List of explicitly allowed images.", func(iap *client.AssurancePolicy) *interface{} { return &iap.AllowedImages }),
+	{
+		name:                "trusted_base_images",
+		types:               []client.AssuranceType{client.AssuranceTypeImage},
+		deprecatedElsewhere: true,
+		schema:              assurancePolicyTrustedBaseImagesSchema,
+		expand: func(d *schema.ResourceData, v interface{}, iap *client.AssurancePolicy) {
+			iap.TrustedBaseImages = expandTrustedBaseImages(v.(*schema.Set).List())
+		},
+		flatten: func(d *schema.ResourceData, iap *client.AssurancePolicy) {
+			d.Set("trusted_base_images", flattenTrustedBaseImageList(d, iap.TrustedBaseImages))
+		},
+	},
+	{
+		// the images of the sets are added by applyTrustedBaseImageSets
+		name:  "trusted_base_images_sets",
+		types: []client.AssuranceType{client.AssuranceTypeImage},
+		input: true,
+		schema: func(client.AssuranceType) *schema.Schema {
+			return &schema.Schema{
+				Type: schema.TypeList,
+				Description: "References of `khulnasoft_trusted_base_images` sets, whose images are added to `trusted_base_images`. " +
+					"The images of the sets are not shown in `trusted_base_images` unless also configured there.",
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateTrustedBaseImageSetReference,
+				},
+			}
+		},
+	},
+	assurancePolicyBool("read_only", "", func(iap *client.AssurancePolicy) *bool { return &iap.ReadOnly }),
+	assurancePolicyBool("force_microenforcer", "", func(iap *client.AssurancePolicy) *bool { return &iap.ForceMicroenforcer }).
+		deprecatedExcept(client.AssuranceTypeImage),
+	assurancePolicyBool("docker_cis_enabled", "Checks the host according to the Docker CIS benchmark, if Docker is found on the host.", func(iap *client.AssurancePolicy) *bool { return &iap.DockerCisEnabled }).
+		deprecatedExcept(client.AssuranceTypeHost),
+	assurancePolicyBool("kube_cis_enabled", "Performs a Kubernetes CIS benchmark check for the host.", func(iap *client.AssurancePolicy) *bool { return &iap.KubeCisEnabled }).
+		deprecatedExcept(client.AssuranceTypeHost),
+	assurancePolicyBool("enforce_excessive_permissions", "", func(iap *client.AssurancePolicy) *bool { return &iap.EnforceExcessivePermissions }).
+		deprecatedExcept(client.AssuranceTypeFunction),
+	assurancePolicyBool("function_integrity_enabled", "", func(iap *client.AssurancePolicy) *bool { return &iap.FunctionIntegrityEnabled }).
+		deprecatedExcept(client.AssuranceTypeFunction),
+	assurancePolicyBool("dta_enabled", "", func(iap *client.AssurancePolicy) *bool { return &iap.DtaEnabled }),
+	assurancePolicyBool("cves_white_list_enabled", "Indicates if CVEs whitelist is relevant.", func(iap *client.AssurancePolicy) *bool { return &iap.CvesWhiteListEnabled }),
+	assurancePolicyStrings("cves_white_list", "List of cves whitelisted licenses", func(iap *client.AssurancePolicy) *[]string { return &iap.CvesWhiteList }).
+		with(func(s *schema.Schema, assuranceType client.AssuranceType) {
+			s.Description = "List of whitelisted CVEs. The CVEs of `cve_exceptions` that did not expire are added to the list."
+			s.Computed = true
+		}),
+	{
+		// the CVEs are added to cves_white_list at plan time by cveExceptionsCustomizeDiff, and by expand for the
+		// policies that are not planned
+		name:  "cve_exceptions",
+		input: true,
+		schema: func(client.AssuranceType) *schema.Schema {
+			return cveExceptionsSchema()
+		},
+		expand: func(d *schema.ResourceData, v interface{}, iap *client.AssurancePolicy) {
+			iap.CvesWhiteList = mergeCveExceptions(iap.CvesWhiteList, v.([]interface{}), time.Now())
+		},
+	},
+	assurancePolicyBool("blacklist_permissions_enabled", "Indicates if blacklist permissions is relevant.", func(iap *client.AssurancePolicy) *bool { return &iap.BlacklistPermissionsEnabled }).
+		deprecatedExcept(client.AssuranceTypeFunction),
+	assurancePolicyList("blacklist_permissions", "List of function's forbidden permissions.", func(iap *client.AssurancePolicy) *[]interface{} { return &iap.BlacklistPermissions }).
+		deprecatedExcept(client.AssuranceTypeFunction),
+	assurancePolicyBool("enabled", "", func(iap *client.AssurancePolicy) *bool { return &iap.Enabled }),
+	assurancePolicyBool("enforce", "", func(iap *client.AssurancePolicy) *bool { return &iap.Enforce }),
+	assurancePolicyInt("enforce_after_days", "", func(iap *client.AssurancePolicy) *int { return &iap.EnforceAfterDays }).
+		with(func(s *schema.Schema, assuranceType client.AssuranceType) {
+			s.ValidateFunc = validation.IntBetween(0, 999)
+		}),
+	assurancePolicyBool("ignore_recently_published_vln", "", func(iap *client.AssurancePolicy) *bool { return &iap.IgnoreRecentlyPublishedVln }),
+	assurancePolicyInt("ignore_recently_published_vln_period", "", func(iap *client.AssurancePolicy) *int { return &iap.IgnoreRecentlyPublishedVlnPeriod }).
+		with(computed),
+	assurancePolicyBool("ignore_recently_published_fix_vln", "", func(iap *client.AssurancePolicy) *bool { return &iap.IgnoreRecentlyPublishedFixVln }),
+	assurancePolicyInt("ignore_recently_published_fix_vln_period", "", func(iap *client.AssurancePolicy) *int { return &iap.IgnoreRecentlyPublishedFixVlnPeriod }).
+		with(computed),
+	assurancePolicyBool("ignore_risk_resources_enabled", "Indicates if risk resources are ignored.", func(iap *client.AssurancePolicy) *bool { return &iap.IgnoreRiskResourcesEnabled }),
+	assurancePolicyStrings("ignored_risk_resources", "List of ignored risk resources.", func(iap *client.AssurancePolicy) *[]string { return &iap.IgnoredRiskResources }),
+	assurancePolicyStrings("application_scopes", "", func(iap *client.AssurancePolicy) *[]string { return &iap.ApplicationScopes }).
+		with(func(s *schema.Schema, assuranceType client.AssuranceType) {
+			s.Optional = false
+			s.Required = true
+		}),
+	assurancePolicyBool("auto_scan_enabled", "", func(iap *client.AssurancePolicy) *bool { return &iap.AutoScanEnabled }),
+	assurancePolicyBool("auto_scan_configured", "", func(iap *client.AssurancePolicy) *bool { return &iap.AutoScanConfigured }),
+	{
+		name:   "auto_scan_time",
+		schema: assurancePolicyAutoScanTimeSchema,
+		expand: func(d *schema.ResourceData, v interface{}, iap *client.AssurancePolicy) {
+			for _, scanTime := range v.(*schema.Set).List() {
+				if scanTime, ok := scanTime.(map[string]interface{}); ok {
+					iap.AutoScanTime = client.ScanTimeAuto{
+						IterationType: scanTime["iteration_type"].(string),
+						Time:          scanTime["time"].(string),
+						Iteration:     scanTime["iteration"].(int),
+						WeekDays:      scanTime["week_days"].([]interface{}),
+					}
+				}
+			}
+		},
+		flatten: func(d *schema.ResourceData, iap *client.AssurancePolicy) {
+			d.Set("auto_scan_time", flattenAutoScanTime(iap.AutoScanTime))
+		},
+	},
+	{
+		name:  "auto_scan_schedule",
+		input: true,
+		schema: func(client.AssuranceType) *schema.Schema {
+			return autoScanScheduleSchema()
+		},
+		expand: func(d *schema.ResourceData, v interface{}, iap *client.AssurancePolicy) {
+			if schedule := v.([]interface{}); len(schedule) > 0 && schedule[0] != nil {
+				iap.AutoScanTime = expandAutoScanSchedule(schedule[0].(map[string]interface{}), time.Now())
+			}
+		},
+		flatten: func(d *schema.ResourceData, iap *client.AssurancePolicy) {
+			if _, ok := d.GetOk("auto_scan_schedule"); ok {
+				d.Set("auto_scan_schedule", flattenAutoScanSchedule(d, iap.AutoScanTime, time.Now()))
+			}
+		},
+	},
+	{
+		name: "auto_scan_next_run",
+		schema: func(client.AssuranceType) *schema.Schema {
+			return autoScanNextRunSchema()
+		},
+		flatten: func(d *schema.ResourceData, iap *client.AssurancePolicy) {
+			d.Set("auto_scan_next_run", autoScanNextRun(iap, time.Now()))
+		},
+	},
+	assurancePolicyBool("required_labels_enabled", "", func(iap *client.AssurancePolicy) *bool { return &iap.RequiredLabelsEnabled }),
+	assurancePolicyLabels("required_labels", func(iap *client.AssurancePolicy) *[]client.Labels { return &iap.RequiredLabels }),
+	assurancePolicyBool("forbidden_labels_enabled", "", func(iap *client.AssurancePolicy) *bool { return &iap.ForbiddenLabelsEnabled }),
+	assurancePolicyLabels("forbidden_labels", func(iap *client.AssurancePolicy) *[]client.Labels { return &iap.ForbiddenLabels }),
+	assurancePolicyString("domain_name", "", func(iap *client.AssurancePolicy) *string { return &iap.DomainName }),
+	assurancePolicyString("domain", "Name of the container image.", func(iap *client.AssurancePolicy) *string { return &iap.Domain }),
+	assurancePolicyString("description", "", func(iap *client.AssurancePolicy) *string { return &iap.Description }),
+	assurancePolicyString("dta_severity", "", func(iap *client.AssurancePolicy) *string { return &iap.DtaSeverity }),
+	assurancePolicyBool("scan_nfs_mounts", "", func(iap *client.AssurancePolicy) *bool { return &iap.ScanNfsMounts }),
+	assurancePolicyString("malware_action", "", func(iap *client.AssurancePolicy) *string { return &iap.MalwareAction }),
+	assurancePolicyBool("partial_results_image_fail", "", func(iap *client.AssurancePolicy) *bool { return &iap.PartialResultsImageFail }).
+		deprecatedExcept(client.AssuranceTypeImage),
+	assurancePolicyBool("maximum_score_exclude_no_fix", "Indicates that policy should ignore cases that do not have a known fix when evaluating maximum score.", func(iap *client.AssurancePolicy) *bool { return &iap.MaximumScoreExcludeNoFix }),
+	{
+		// sent but not read back, the console updates it on every change
+		name: "lastupdate",
+		schema: func(client.AssuranceType) *schema.Schema {
+			return &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			}
+		},
+		expand: func(d *schema.ResourceData, v interface{}, iap *client.AssurancePolicy) {
+			iap.Lastupdate = v.(string)
+		},
+	},
+	assurancePolicyString("custom_severity", "Custom severity level for vulnerability assessment.", func(iap *client.AssurancePolicy) *string { return &iap.CustomSeverity }).
+		with(computed),
+	assurancePolicyBool("vulnerability_exploitability", "Enable vulnerability exploitability assessment.", func(iap *client.AssurancePolicy) *bool { return &iap.VulnerabilityExploitability }),
+	assurancePolicyStrings("disallow_exploit_types", "", func(iap *client.AssurancePolicy) *[]string { return &iap.DisallowExploitTypes }),
+	assurancePolicyBool("ignore_base_image_vln", "", func(iap *client.AssurancePolicy) *bool { return &iap.IgnoreBaseImageVln }),
+	assurancePolicyStrings("ignored_sensitive_resources", "", func(iap *client.AssurancePolicy) *[]string { return &iap.IgnoredSensitiveResources }),
+	assurancePolicyString("permission", "", func(iap *client.AssurancePolicy) *string { return &iap.Permission }).
+		with(computed),
+	assurancePolicyBool("scan_malware_in_archives", "", func(iap *client.AssurancePolicy) *bool { return &iap.ScanMalwareInArchives }),
+	{
+		name:                "kubernetes_controls",
+		types:               []client.AssuranceType{client.AssuranceTypeKubernetes},
+		deprecatedElsewhere: true,
+		schema:              assurancePolicyKubernetesControlsSchema,
+		expand: func(d *schema.ResourceData, v interface{}, iap *client.AssurancePolicy) {
+			controls, ok := v.([]interface{})
+			if set, isSet := v.(*schema.Set); isSet {
+				controls, ok = set.List(), true
+			}
+			if !ok {
+				return
+			}
+			for _, control := range controls {
+				// the host policies still declare the controls as a list of strings
+				control, ok := control.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				iap.KubernetesControls = append(iap.KubernetesControls, client.KubernetesControls{
+					ScriptID:    control["script_id"].(int),
+					Name:        control["name"].(string),
+					Description: control["description"].(string),
+					Enabled:     control["enabled"].(bool),
+					Severity:    control["severity"].(string),
+					Kind:        control["kind"].(string),
+					OOTB:        control["ootb"].(bool),
+					AvdID:       control["avd_id"].(string),
+				})
+			}
+		},
+		flatten: func(d *schema.ResourceData, iap *client.AssurancePolicy) {
+			if iap.AssuranceType == string(client.AssuranceTypeHost) {
+				return
+			}
+			d.Set("kubernetes_controls", flattenKubernetesControls(iap.KubernetesControls))
+		},
+	},
+	assurancePolicyStrings("kubernetes_controls_names", "List of kubernetes control names and available kubernetes controls are: 'Access to host IPC namespace', 'Access to host PID', 'Access to host network', 'Access to host ports', 'All container images must start with a GCR domain', 'All container images must start with an ECR domain', 'All container images must start with the *.azurecr.io domain', 'CPU not limited', 'CPU requests not specified', 'Can elevate its own privileges', 'ConfigMap with secrets', 'ConfigMap with sensitive content', 'Container images from public registries used', 'Default capabilitiessome containers do not drop all', 'Default capabilitiessome containers do not drop any', 'Delete pod logs', 'Exec into Pods', 'Image tag :latest used', 'Manage EKS IAM Auth ConfigMap', 'Manage Kubernetes RBAC resources', 'Manage Kubernetes networking', 'Manage Kubernetes workloads and pods', 'Manage all resources', 'Manage all resources at the namespace', 'Manage configmaps', 'Manage namespace secrets', 'Manage secrets', 'Manage webhookconfigurations', 'Manages /etc/hosts', 'Memory not limited', 'Memory requests not specified', 'Non-core volume types used.', 'Non-default /proc masks set', 'Privileged', 'Root file system is not read-only', 'Runs as root user', 'Runs with GID <= 10000', 'Runs with UID <= 10000', 'Runs with a root primary or supplementary GID', 'Runtime/Default AppArmor profile not set', 'Runtime/Default Seccomp profile not set', 'SELinux custom options set', 'SYS_ADMIN capability added', 'Seccomp policies disabled', 'Service with External IP', 'Specific capabilities added', 'Unsafe sysctl options set', 'User with admin access', 'Workloads in the default namespace', 'hostPath volume mounted with docker.sock', 'hostPath volumes mounted'", func(iap *client.AssurancePolicy) *[]string { return &iap.KubenetesControlsNames }).
+		deprecatedExcept(client.AssuranceTypeKubernetes),
+	assurancePolicyBool("scan_windows_registry", "", func(iap *client.AssurancePolicy) *bool { return &iap.ScanWindowsRegistry }).
+		deprecatedExcept(client.AssuranceTypeHost),
+	assurancePolicyBool("scan_process_memory", "", func(iap *client.AssurancePolicy) *bool { return &iap.ScanProcessMemory }).
+		deprecatedExcept(client.AssuranceTypeHost),
+	{
+		name:   "policy_settings",
+		schema: assurancePolicySettingsSchema,
+		expand: func(d *schema.ResourceData, v interface{}, iap *client.AssurancePolicy) {
+			settings := v.([]interface{})[0].(map[string]interface{})
+			iap.PolicySettings = client.PolicySettings{
+				Enforce:        settings["enforce"].(bool),
+				Warn:           settings["warn"].(bool),
+				WarningMessage: settings["warning_message"].(string),
+				IsAuditChecked: settings["is_audit_checked"].(bool),
+			}
+		},
+		flatten: func(d *schema.ResourceData, iap *client.AssurancePolicy) {
+			d.Set("policy_settings", flattenPolicySettings(iap.PolicySettings))
+		},
+	},
+	assurancePolicyStrings("exclude_application_scopes", "", func(iap *client.AssurancePolicy) *[]string { return &iap.ExcludeApplicationScopes }),
+	assurancePolicyBool("linux_cis_enabled", "", func(iap *client.AssurancePolicy) *bool { return &iap.LinuxCisEnabled }).
+		deprecatedExcept(client.AssuranceTypeHost),
+	assurancePolicyBool("windows_cis_enabled", "Checks the host according to the Windows CIS benchmark (relevant for hosts running Windows).", func(iap *client.AssurancePolicy) *bool { return &iap.WindowsCisEnabled }).
+		only(client.AssuranceTypeHost),
+	assurancePolicyBool("openshift_hardening_enabled", "", func(iap *client.AssurancePolicy) *bool { return &iap.OpenshiftHardeningEnabled }).
+		deprecatedExcept(client.AssuranceTypeHost),
+	assurancePolicyStrings("kubernetes_controls_avd_ids", "", func(iap *client.AssurancePolicy) *[]string { return &iap.KubernetesControlsAvdIds }).
+		with(computed).
+		deprecatedExcept(client.AssuranceTypeKubernetes),
+	{
+		name: "vulnerability_score_range",
+		schema: func(client.AssuranceType) *schema.Schema {
+			return &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			}
+		},
+		expand: func(d *schema.ResourceData, v interface{}, iap *client.AssurancePolicy) {
+			iap.VulnerabilityScoreRange = convertIntArr(v.([]interface{}))
+		},
+		flatten: func(d *schema.ResourceData, iap *client.AssurancePolicy) {
+			d.Set("vulnerability_score_range", iap.VulnerabilityScoreRange)
+		},
+	},
+	{
+		// not supported by the console API yet, kept for compatibility with configurations setting it
+		name:       "aggregated_vulnerability",
+		dataSchema: assurancePolicyAggregatedVulnerabilityDataSchema,
+		schema: func(client.AssuranceType) *schema.Schema {
+			return &schema.Schema{
+				Type:        schema.TypeMap,
+				Description: "Aggregated vulnerability information.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			}
+		},
+	},
+	{
+		// not read from the console, kept for compatibility with configurations reading it
+		name: "category",
+		dataSchema: func(client.AssuranceType) *schema.Schema {
+			return &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			}
+		},
+	},
+}
+
+// appliesTo reports whether the attribute applies to the assurance policies of the given type
+func (f assurancePolicyField) appliesTo(assuranceType client.AssuranceType) bool {
+	if len(f.types) == 0 {
+		return true
+	}
+	for _, t := range f.types {
+		if t == assuranceType {
+			return true
+		}
+	}
+	return false
+}
+
+// declaredBy reports whether the attribute is declared by the assurance policies of the given type
+func (f assurancePolicyField) declaredBy(assuranceType client.AssuranceType) bool {
+	return f.deprecatedElsewhere || f.appliesTo(assuranceType)
+}
+
+// only restricts the attribute to the given assurance types
+func (f assurancePolicyField) only(types ...client.AssuranceType) assurancePolicyField {
+	f.types = types
+	return f
+}
+
+// deprecatedExcept applies the attribute to the given assurance types, the other types declaring it as deprecated
+func (f assurancePolicyField) deprecatedExcept(types ...client.AssuranceType) assurancePolicyField {
+	f.types = types
+	f.deprecatedElsewhere = true
+	return f
+}
+
+// resourceSchema returns the attribute of the resources of the given type, deprecated on the types it doesn't apply to
+func (f assurancePolicyField) resourceSchema(assuranceType client.AssuranceType) *schema.Schema {
+	s := f.schema(assuranceType)
+	if !f.appliesTo(assuranceType) {
+		s.Deprecated = fmt.Sprintf("%s is not supported by %s policies and will be removed in a future release.", f.name, getAssurancePolicyKind(assuranceType).label)
+	}
+	return s
+}
+
+// exposed reports whether the data sources expose the attribute
+func (f assurancePolicyField) exposed() bool {
+	return f.dataSchema != nil || (!f.input && f.flatten != nil)
+}
+
+// with customizes the schema of the attribute
+func (f assurancePolicyField) with(customize func(s *schema.Schema, assuranceType client.AssuranceType)) assurancePolicyField {
+	fieldSchema := f.schema
+	f.schema = func(assuranceType client.AssuranceType) *schema.Schema {
+		s := fieldSchema(assuranceType)
+		customize(s, assuranceType)
+		return s
+	}
+	return f
+}
+
+func computed(s *schema.Schema, assuranceType client.AssuranceType) {
+	s.Computed = true
+}
+
+func defaultValue(value interface{}) func(s *schema.Schema, assuranceType client.AssuranceType) {
+	return func(s *schema.Schema, assuranceType client.AssuranceType) {
+		s.Default = value
+	}
+}
+
+// assurancePolicyTypeFields returns the attributes declared by the assurance policies of the given type
+func assurancePolicyTypeFields(assuranceType client.AssuranceType) []assurancePolicyField {
+	var fields []assurancePolicyField
+	for _, field := range assurancePolicyFields {
+		if field.declaredBy(assuranceType) {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+func getAssurancePolicyField(name string) assurancePolicyField {
+	for _, field := range assurancePolicyFields {
+		if field.name == name {
+			return field
+		}
+	}
+	panic(fmt.Sprintf("unknown assurance policy attribute %q", name))
+}
+
+// assurancePolicySchema returns the attributes of the assurance policy resource of the given type
+func assurancePolicySchema(assuranceType client.AssuranceType) map[string]*schema.Schema {
+	policySchema := map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+			Optional: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+	}
+	for _, field := range assurancePolicyTypeFields(assuranceType) {
+		if field.schema != nil {
+			policySchema[field.name] = field.resourceSchema(assuranceType)
+		}
+	}
+	addBasePolicySchema(policySchema, getAssurancePolicyKind(assuranceType).label)
+	return policySchema
+}
+
+// assurancePolicyDataSchema returns the attributes of the assurance policy data source of the given type, the
+// attributes of the resource read from the console
+func assurancePolicyDataSchema(assuranceType client.AssuranceType) map[string]*schema.Schema {
+	policySchema := map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	}
+	for _, field := range assurancePolicyTypeFields(assuranceType) {
+		switch {
+		case field.dataSchema != nil:
+			policySchema[field.name] = field.dataSchema(assuranceType)
+		case field.exposed():
+			policySchema[field.name] = computedSchema(field.schema(assuranceType))
+		}
+	}
+	return policySchema
+}
+
+// computedSchema returns the computed counterpart of a resource attribute, for data sources
+func computedSchema(attribute *schema.Schema) *schema.Schema {
+	computedAttribute := &schema.Schema{
+		Type:        attribute.Type,
+		Description: attribute.Description,
+		Computed:    true,
+	}
+	switch elem := attribute.Elem.(type) {
+	case *schema.Schema:
+		computedAttribute.Elem = &schema.Schema{Type: elem.Type}
+	case *schema.Resource:
+		nested := make(map[string]*schema.Schema, len(elem.Schema))
+		for name, nestedAttribute := range elem.Schema {
+			nested[name] = computedSchema(nestedAttribute)
+		}
+		computedAttribute.Elem = &schema.Resource{Schema: nested}
+	}
+	return computedAttribute
+}
+
+// expandAssurancePolicy expands the attributes of the assurance policies of the given type
+func expandAssurancePolicy(d *schema.ResourceData, assuranceType client.AssuranceType) *client.AssurancePolicy {
+	iap := client.AssurancePolicy{
+		AssuranceType:      string(assuranceType),
+		Name:               d.Get("name").(string),
+		KubernetesControls: make(client.KubernetesControlsArray, 0),
+	}
+	for _, field := range assurancePolicyTypeFields(assuranceType) {
+		if field.expand == nil {
+			continue
+		}
+		if v, ok := d.GetOk(field.name); ok {
+			field.expand(d, v, &iap)
+		}
+	}
+	return &iap
+}
+
+// flattenAssurancePolicy sets the attributes of the assurance policies of the given type read from the console
+func flattenAssurancePolicy(d *schema.ResourceData, iap *client.AssurancePolicy, assuranceType client.AssuranceType) {
+	d.Set("name", iap.Name)
+	for _, field := range assurancePolicyTypeFields(assuranceType) {
+		if field.flatten != nil {
+			field.flatten(d, iap)
+		}
+	}
+}
+
+// flattenAssurancePolicyData sets the attributes of the assurance policy data sources of the given type
+func flattenAssurancePolicyData(d *schema.ResourceData, iap *client.AssurancePolicy, assuranceType client.AssuranceType) {
+	for _, field := range assurancePolicyTypeFields(assuranceType) {
+		if field.flatten != nil && !field.input {
+			field.flatten(d, iap)
+		}
+	}
+}
+
+func assurancePolicyPrimitiveSchema(attributeType schema.ValueType, description string) func(client.AssuranceType) *schema.Schema {
+	return func(client.AssuranceType) *schema.Schema {
+		return &schema.Schema{
+			Type:        attributeType,
+			Description: description,
+			Optional:    true,
+		}
+	}
+}
+
+func assurancePolicyListSchema(description string) func(client.AssuranceType) *schema.Schema {
+	return func(client.AssuranceType) *schema.Schema {
+		return &schema.Schema{
+			Type:        schema.TypeList,
+			Description: description,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		}
+	}
+}
+
+// assurancePolicyBool is an attribute made of a single boolean field of the assurance policy
+func assurancePolicyBool(name, description string, field func(iap *client.AssurancePolicy) *bool) assurancePolicyField {
+	return assurancePolicyField{
+		name:   name,
+		schema: assurancePolicyPrimitiveSchema(schema.TypeBool, description),
+		expand: func(d *schema.ResourceData, v interface{}, iap *client.AssurancePolicy) {
+			*field(iap) = v.(bool)
+		},
+		flatten: func(d *schema.ResourceData, iap *client.AssurancePolicy) {
+			d.Set(name, *field(iap))
+		},
+	}
+}
+
+// assurancePolicyString is an attribute made of a single string field of the assurance policy
+func assurancePolicyString(name, description string, field func(iap *client.AssurancePolicy) *string) assurancePolicyField {
+	return assurancePolicyField{
+		name:   name,
+		schema: assurancePolicyPrimitiveSchema(schema.TypeString, description),
+		expand: func(d *schema.ResourceData, v interface{}, iap *client.AssurancePolicy) {
+			*field(iap) = v.(string)
+		},
+		flatten: func(d *schema.ResourceData, iap *client.AssurancePolicy) {
+			d.Set(name, *field(iap))
+		},
+	}
+}
+
+// assurancePolicyInt is an attribute made of a single integer field of the assurance policy
+func assurancePolicyInt(name, description string, field func(iap *client.AssurancePolicy) *int) assurancePolicyField {
+	return assurancePolicyField{
+		name:   name,
+		schema: assurancePolicyPrimitiveSchema(schema.TypeInt, description),
+		expand: func(d *schema.ResourceData, v interface{}, iap *client.AssurancePolicy) {
+			*field(iap) = v.(int)
+		},
+		flatten: func(d *schema.ResourceData, iap *client.AssurancePolicy) {
+			d.Set(name, *field(iap))
+		},
+	}
+}
+
+// assurancePolicyFloat is an attribute made of a single float field of the assurance policy
+func assurancePolicyFloat(name, description string, field func(iap *client.AssurancePolicy) *float64) assurancePolicyField {
+	return assurancePolicyField{
+		name:   name,
+		schema: assurancePolicyPrimitiveSchema(schema.TypeFloat, description),
+		expand: func(d *schema.ResourceData, v interface{}, iap *client.AssurancePolicy) {
+			*field(iap) = v.(float64)
+		},
+		flatten: func(d *schema.ResourceData, iap *client.AssurancePolicy) {
+			d.Set(name, *field(iap))
+		},
+	}
+}
+
+// assurancePolicyStrings is a list of strings stored in a []string field of the assurance policy
+func assurancePolicyStrings(name, description string, field func(iap *client.AssurancePolicy) *[]string) assurancePolicyField {
+	return assurancePolicyField{
+		name:   name,
+		schema: assurancePolicyListSchema(description),
+		expand: func(d *schema.ResourceData, v interface{}, iap *client.AssurancePolicy) {
+			*field(iap) = convertStringArr(v.([]interface{}))
+		},
+		flatten: func(d *schema.ResourceData, iap *client.AssurancePolicy) {
+			d.Set(name, *field(iap))
+		},
+	}
+}
+
+// assurancePolicyList is a list of strings stored in a []interface{} field of the assurance policy
+func assurancePolicyList(name, description string, field func(iap *client.AssurancePolicy) *[]interface{}) assurancePolicyField {
+	return assurancePolicyField{
+		name:   name,
+		schema: assurancePolicyListSchema(description),
+		expand: func(d *schema.ResourceData, v interface{}, iap *client.AssurancePolicy) {
+			*field(iap) = v.([]interface{})
+		},
+		flatten: func(d *schema.ResourceData, iap *client.AssurancePolicy) {
+			d.Set(name, *field(iap))
+		},
+	}
+}
+
+// assurancePolicyAnyList is a list of strings stored in an interface{} field of the assurance policy
+func assurancePolicyAnyList(name, description string, field func(iap *client.AssurancePolicy) *interface{}) assurancePolicyField {
+	return assurancePolicyField{
+		name:   name,
+		schema: assurancePolicyListSchema(description),
+		expand: func(d *schema.ResourceData, v interface{}, iap *client.AssurancePolicy) {
+			*field(iap) = v
+		},
+		flatten: func(d *schema.ResourceData, iap *client.AssurancePolicy) {
+			d.Set(name, *field(iap))
+		},
+	}
+}
+
+// assurancePolicyLicenses is a list of SPDX license expressions, completed by the licenses of its categories
+func assurancePolicyLicenses(name string, field func(iap *client.AssurancePolicy) *[]string) assurancePolicyField {
+	return assurancePolicyStrings(name, "", field).
+		with(func(s *schema.Schema, assuranceType client.AssuranceType) {
+			s.Description = fmt.Sprintf("List of %s licenses, as SPDX license identifiers or expressions such as `MIT OR Apache-2.0`. "+
+				"Licenses without an SPDX identifier are written `LicenseRef-<name>`. The licenses of `%s_categories` are added to the list.", strings.TrimSuffix(name, "_licenses"), name)
+			s.Computed = true
+			s.Elem = &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateLicenseExpression,
+			}
+		})
+}
+
+// assurancePolicyLicenseCategories adds the licenses of the configured categories to the license list listKey
+func assurancePolicyLicenseCategories(listKey string, field func(iap *client.AssurancePolicy) *[]string) assurancePolicyField {
+	return assurancePolicyField{
+		name:  listKey + "_categories",
+		input: true,
+		schema: func(client.AssuranceType) *schema.Schema {
+			return licenseCategoriesSchema(listKey)
+		},
+		expand: func(d *schema.ResourceData, v interface{}, iap *client.AssurancePolicy) {
+			*field(iap) = mergeLicenseCategories(*field(iap), convertStringArr(v.([]interface{})))
+		},
+	}
+}
+
+// assurancePolicyPackages is a package list, whose entries loaded from its file are not read back
+func assurancePolicyPackages(name, description string, field func(iap *client.AssurancePolicy) *[]client.ListPackages) assurancePolicyField {
+	return assurancePolicyField{
+		name: name,
+		schema: func(client.AssuranceType) *schema.Schema {
+			return assurancePolicyPackagesSchema(description)
+		},
+		expand: func(d *schema.ResourceData, v interface{}, iap *client.AssurancePolicy) {
+			*field(iap) = expandPackages(v.(*schema.Set).List())
+		},
+		flatten: func(d *schema.ResourceData, iap *client.AssurancePolicy) {
+			d.Set(name, flattenPackageList(d, name+"_file", *field(iap)))
+		},
+	}
+}
+
+// assurancePolicyPackageListFile is the file of a package list, whose entries are added by applyPackageListFiles
+func assurancePolicyPackageListFile(listKey string) assurancePolicyField {
+	return assurancePolicyField{
+		name:  listKey + "_file",
+		input: true,
+		schema: func(client.AssuranceType) *schema.Schema {
+			return packageListFileSchema(listKey)
+		},
+	}
+}
+
+// assurancePolicyLabels is a set of key/value labels
+func assurancePolicyLabels(name string, field func(iap *client.AssurancePolicy) *[]client.Labels) assurancePolicyField {
+	return assurancePolicyField{
+		name:   name,
+		schema: assurancePolicyLabelsSchema,
+		expand: func(d *schema.ResourceData, v interface{}, iap *client.AssurancePolicy) {
+			labels := v.(*schema.Set).List()
+			expanded := make([]client.Labels, len(labels))
+			for i, label := range labels {
+				label := label.(map[string]interface{})
+				expanded[i] = client.Labels{
+					Key:   label["key"].(string),
+					Value: label["value"].(string),
+				}
+			}
+			*field(iap) = expanded
+		},
+		flatten: func(d *schema.ResourceData, iap *client.AssurancePolicy) {
+			d.Set(name, flattenLabels(*field(iap)))
+		},
+	}
+}
+
+func assurancePolicyCustomChecksSchema(client.AssuranceType) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "List of Custom user scripts for checks.",
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"script_id": {
+					Type:        schema.TypeString,
+					Description: "ID of the custom compliance script, e.g. the `script_id` of a `khulnasoft_custom_compliance_script`. When only the ID is set, the other attributes are read from the script on every create and update of the policy.",
+					Optional:    true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"path": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"last_modified": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"description": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"engine": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"snippet": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"read_only": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Computed: true,
+				},
+				"severity": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"author": {
+					Type:        schema.TypeString,
+					Description: "Name of user account that created the policy.",
+					Optional:    true,
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func assurancePolicyScopeSchema(client.AssuranceType) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"expression": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validateScopeExpression,
+				},
+				"variables": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"attribute": {
+								Type:     schema.TypeString,
+								Optional: true,
+								Computed: true,
+							},
+							"value": {
+								Type:     schema.TypeString,
+								Optional: true,
+								Computed: true,
+							},
+							"name": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// assurancePolicyScopeDataSchema is the scope exposed by the data sources, whose variables are a set
+func assurancePolicyScopeDataSchema(assuranceType client.AssuranceType) *schema.Schema {
+	scope := computedSchema(assurancePolicyScopeSchema(assuranceType))
+	scope.Elem.(*schema.Resource).Schema["variables"].Type = schema.TypeSet
+	return scope
+}
+
+// assurancePolicyAggregatedVulnerabilityDataSchema is the aggregated vulnerability exposed by the data sources, not
+// read from the console yet
+func assurancePolicyAggregatedVulnerabilityDataSchema(client.AssuranceType) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Aggregated vulnerability information.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enabled": {
+					Type:        schema.TypeBool,
+					Description: "Enable the aggregated vulnerability",
+					Computed:    true,
+				},
+				"score_range": {
+					Type:        schema.TypeList,
+					Description: "Indicates score range for vuln score eg [5.5, 6.0]",
+					Computed:    true,
+					Elem: &schema.Schema{
+						Type: schema.TypeFloat,
+					},
+				},
+				"custom_severity_enabled": {
+					Type:        schema.TypeBool,
+					Description: "Indicates to consider custom severity during control evaluation",
+					Computed:    true,
+				},
+				"severity": {
+					Type:        schema.TypeString,
+					Description: "Max severity to be allowed in the image",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func assurancePolicyPackagesSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Description: description,
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"format": {
+					Type:         schema.TypeString,
+					Description:  "Package ecosystem, one of `apk`, `cargo`, `composer`, `deb`, `gem`, `go`, `jar`, `npm`, `nuget`, `pypi` or `rpm`. `epoch` and `arch` only apply to `rpm` and `deb` (and `apk` for `arch`), `release` to `rpm`.",
+					Optional:     true,
+					ValidateFunc: validatePackageFormat,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"epoch": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"version": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"version_range": {
+					Type:         schema.TypeString,
+					Description:  "Range of versions, constraints such as `>=1.2.0` separated by commas or spaces, alternatives separated by `||`, e.g. `>=1.2.0, <2.0.0 || >=3.0.0`. Conflicts with `version`.",
+					Optional:     true,
+					ValidateFunc: validatePackageVersionRange,
+				},
+				"release": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"arch": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"license": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"display": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func assurancePolicyTrustedBaseImagesSchema(client.AssuranceType) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Description: "List of trusted images.",
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"registry": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"imagename": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func assurancePolicyAutoScanTimeSchema(assuranceType client.AssuranceType) *schema.Schema {
+	autoScanTime := &schema.Schema{
+		Type:       schema.TypeSet,
+		Deprecated: "Use auto_scan_schedule instead.",
+		Optional:   true,
+		Computed:   true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"iteration_type": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "once",
+				},
+				"time": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "",
+				},
+				"iteration": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Default:  1,
+				},
+				"week_days": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+	if assuranceType != client.AssuranceTypeImage {
+		// the policies of the other types default to the schedule of the console
+		nested := autoScanTime.Elem.(*schema.Resource).Schema
+		for _, name := range []string{"iteration_type", "time", "iteration"} {
+			nested[name].Default = nil
+		}
+		nested["iteration"].Computed = true
+		nested["week_days"].Computed = true
+	}
+	return autoScanTime
+}
+
+func assurancePolicyLabelsSchema(client.AssuranceType) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func assurancePolicyKubernetesControlsSchema(assuranceType client.AssuranceType) *schema.Schema {
+	if assuranceType == client.AssuranceTypeHost {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		}
+	}
+	controlsType := schema.TypeList
+	if assuranceType == client.AssuranceTypeCFApplication {
+		controlsType = schema.TypeSet
+	}
+	return &schema.Schema{
+		Type:        controlsType,
+		Description: "List of Kubernetes controls.",
+		Optional:    true,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"script_id": {
+					Type:        schema.TypeInt,
+					Description: "Script ID.",
+					Optional:    true,
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Description: "Name of the control.",
+					Optional:    true,
+				},
+				"description": {
+					Type:        schema.TypeString,
+					Description: "Description of the control.",
+					Optional:    true,
+				},
+				"enabled": {
+					Type:        schema.TypeBool,
+					Description: "Is the control enabled?",
+					Optional:    true,
+				},
+				"severity": {
+					Type:        schema.TypeString,
+					Description: "Severity of the control.",
+					Optional:    true,
+				},
+				"kind": {
+					Type:        schema.TypeString,
+					Description: "Kind of the control.",
+					Optional:    true,
+				},
+				"ootb": {
+					Type:        schema.TypeBool,
+					Description: "Out-of-the-box status of the control.",
+					Optional:    true,
+				},
+				"avd_id": {
+					Type:        schema.TypeString,
+					Description: "AVD ID.",
+					Optional:    true,
+				},
+			},
+		},
+	}
+}
+
+func assurancePolicySettingsSchema(client.AssuranceType) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		MaxItems: 1,
+		Optional: true,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enforce": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+				"warn": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+				"warning_message": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "",
+				},
+				"is_audit_checked": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	}
+}
+
+func expandCustomChecks(checks []interface{}) []client.Checks {
+	expanded := make([]client.Checks, len(checks))
+	for i, check := range checks {
+		check := check.(map[string]interface{})
+		expanded[i] = client.Checks{
+			ScriptID:     check["script_id"].(string),
+			Name:         check["name"].(string),
+			Path:         check["path"].(string),
+			LastModified: check["last_modified"].(int),
+			Description:  check["description"].(string),
+			Engine:       check["engine"].(string),
+			Snippet:      check["snippet"].(string),
+			ReadOnly:     check["read_only"].(bool),
+			Severity:     check["severity"].(string),
+			Author:       check["author"].(string),
+		}
+	}
+	return expanded
+}
+
+func expandAssurancePolicyScope(scope map[string]interface{}) client.Scopes {
+	variables := scope["variables"].([]interface{})
+	expanded := client.Scopes{
+		Expression: scope["expression"].(string),
+		Variables:  make([]client.VariableI, len(variables)),
+	}
+	for i, variable := range variables {
+		variable := variable.(map[string]interface{})
+		expanded.Variables[i] = client.VariableI{
+			Attribute: variable["attribute"].(string),
+			Name:      variable["name"].(string),
+			Value:     variable["value"].(string),
+		}
+	}
+	return expanded
+}
+
+func flatteniapscope(scope1 client.Scopes) []map[string]interface{} {
+	return []map[string]interface{}{
+		{
+			"expression": scope1.Expression,
+			"variables":  flattenscopevariables(scope1.Variables),
+		},
+	}
+}
+
+func flattenscopevariables(variable []client.VariableI) []interface{} {
+	check := make([]interface{}, len(variable))
+	for i := range variable {
+		check[i] = map[string]interface{}{
+			"attribute": variable[i].Attribute,
+			"value":     variable[i].Value,
+			"name":      variable[i].Name,
+		}
+	}
+
+	return check
+}
+
+func flattenAutoScanTime(scantime client.ScanTimeAuto) []map[string]interface{} {
+	return []map[string]interface{}{
+		{
+			"iteration_type": scantime.IterationType,
+			"time":           scantime.Time,
+			"iteration":      scantime.Iteration,
+			"week_days":      scantime.WeekDays,
+		},
+	}
+}
+
+func flattenCustomChecks(checks []client.Checks) []map[string]interface{} {
+	check := make([]map[string]interface{}, len(checks))
+	for i := range checks {
+		check[i] = map[string]interface{}{
+			"script_id":     checks[i].ScriptID,
+			"name":          checks[i].Name,
+			"path":          checks[i].Path,
+			"last_modified": checks[i].LastModified,
+			"description":   checks[i].Description,
+			"engine":        checks[i].Engine,
+			"snippet":       checks[i].Snippet,
+			"read_only":     checks[i].ReadOnly,
+			"severity":      checks[i].Severity,
+			"author":        checks[i].Author,
+		}
+	}
+	return check
+}
+
+func flattenLabels(labels []client.Labels) []map[string]interface{} {
+	label := make([]map[string]interface{}, len(labels))
+	for i := range labels {
+		label[i] = map[string]interface{}{
+			"key":   labels[i].Key,
+			"value": labels[i].Value,
+		}
+	}
+	return label
+}
+
+func flattenPackages(packages []client.ListPackages) []map[string]interface{} {
+	package1 := make([]map[string]interface{}, len(packages))
+	for i := range packages {
+		package1[i] = map[string]interface{}{
+			"format":        packages[i].Format,
+			"name":          packages[i].Name,
+			"epoch":         packages[i].Epoch,
+			"version":       packages[i].Version,
+			"version_range": packages[i].VersionRange,
+			"release":       packages[i].Release,
+			"arch":          packages[i].Arch,
+			"license":       packages[i].License,
+			"display":       packages[i].Display,
+		}
+	}
+	return package1
+}
+
+func flattenTrustedBaseImages(TrustedBaseImages []client.BaseImagesTrusted) []map[string]interface{} {
+	tbi := make([]map[string]interface{}, len(TrustedBaseImages))
+	for i, v := range TrustedBaseImages {
+		tbi[i] = map[string]interface{}{
+			"registry":  v.Registry,
+			"imagename": v.Imagename,
+		}
+	}
+	return tbi
+}
+
+func flattenPolicySettings(policySettings client.PolicySettings) []map[string]interface{} {
+	return []map[string]interface{}{
+		{
+			"enforce":          policySettings.Enforce,
+			"warn":             policySettings.Warn,
+			"warning_message":  policySettings.WarningMessage,
+			"is_audit_checked": policySettings.IsAuditChecked,
+		},
+	}
+}
+
+func flattenKubernetesControls(kubernetesControls client.KubernetesControlsArray) []interface{} {
+	var flattenedControls []interface{}
+
+	for _, control := range kubernetesControls {
+		flattenedControl := map[string]interface{}{
+			"script_id":   control.ScriptID,
+			"name":        control.Name,
+			"description": control.Description,
+			"enabled":     control.Enabled,
+			"severity":    control.Severity,
+			"kind":        control.Kind,
+			"ootb":        control.OOTB,
+			"avd_id":      control.AvdID,
+		}
+		flattenedControls = append(flattenedControls, flattenedControl)
+	}
+
+	return flattenedControls
+}
@@ -0,0 +1,153 @@
+package khulnasoft
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAssurancePolicySchemaTypes(t *testing.T) {
+	for _, assuranceType := range client.AssuranceTypes {
+		policySchema := assurancePolicySchema(assuranceType)
+		dataSchema := assurancePolicyDataSchema(assuranceType)
+		for _, field := range assurancePolicyFields {
+			attribute, declared := policySchema[field.name]
+			if declared != (field.declaredBy(assuranceType) && field.schema != nil) {
+				t.Errorf("%s: expected %s to be declared %v, got %v", assuranceType, field.name, field.declaredBy(assuranceType), declared)
+			}
+			if declared && !field.appliesTo(assuranceType) && attribute.Deprecated == "" {
+				t.Errorf("%s: expected %s to be deprecated", assuranceType, field.name)
+			}
+			if declared && field.appliesTo(assuranceType) && strings.Contains(attribute.Deprecated, "is not supported by") {
+				t.Errorf("%s: unexpected deprecation of %s", assuranceType, field.name)
+			}
+			_, exposed := dataSchema[field.name]
+			if exposed != (field.declaredBy(assuranceType) && field.exposed()) {
+				t.Errorf("%s: unexpected data source attribute %s", assuranceType, field.name)
+			}
+		}
+		for name, attribute := range dataSchema {
+			if name != "name" && (!attribute.Computed || attribute.Optional) {
+				t.Errorf("%s: expected data source attribute %s to be computed", assuranceType, name)
+			}
+		}
+	}
+
+	for attribute, assuranceType := range map[string]client.AssuranceType{
+		"windows_cis_enabled":      client.AssuranceTypeHost,
+		"trusted_base_images_sets": client.AssuranceTypeImage,
+	} {
+		for _, other := range client.AssuranceTypes {
+			if _, ok := assurancePolicySchema(other)[attribute]; ok != (other == assuranceType) {
+				t.Errorf("expected %s to only apply to %s policies", attribute, assuranceType)
+			}
+		}
+	}
+	for attribute, assuranceType := range map[string]client.AssuranceType{
+		"function_integrity_enabled": client.AssuranceTypeFunction,
+		"kubernetes_controls":        client.AssuranceTypeKubernetes,
+	} {
+		for _, other := range client.AssuranceTypes {
+			if deprecated := assurancePolicySchema(other)[attribute].Deprecated != ""; deprecated != (other != assuranceType) {
+				t.Errorf("expected %s to be deprecated on the policies other than %s", attribute, assuranceType)
+			}
+		}
+	}
+
+	if assurancePolicySchema(client.AssuranceTypeKubernetes)["fail_cicd"].Default != false {
+		t.Error("expected fail_cicd to default to false for kubernetes policies")
+	}
+}
+
+func TestAssurancePolicyRoundTrip(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, assurancePolicySchema(client.AssuranceTypeHost), map[string]interface{}{
+		"name":                              "production",
+		"application_scopes":                []interface{}{"Global"},
+		"cvss_severity_enabled":             true,
+		"cvss_severity":                     "high",
+		"maximum_score":                     7.5,
+		"windows_cis_enabled":               true,
+		"ignore_recently_published_fix_vln": true,
+		"cves_black_list":                   []interface{}{"CVE-2021-44228"},
+		"required_labels": []interface{}{
+			map[string]interface{}{"key": "team", "value": "security"},
+		},
+	})
+	iap := expandAssurancePolicy(d, client.AssuranceTypeHost)
+	if iap.AssuranceType != "host" || iap.Name != "production" || !iap.WindowsCisEnabled || !iap.IgnoreRecentlyPublishedFixVln ||
+		iap.CvssSeverity != "high" || iap.MaximumScore != 7.5 || len(iap.RequiredLabels) != 1 || !iap.FailCicd {
+		t.Errorf("unexpected policy %+v", iap)
+	}
+
+	read := schema.TestResourceDataRaw(t, assurancePolicyDataSchema(client.AssuranceTypeHost), map[string]interface{}{})
+	flattenAssurancePolicyData(read, iap, client.AssuranceTypeHost)
+	for attribute, expected := range map[string]interface{}{
+		"assurance_type":         "host",
+		"cvss_severity":          "high",
+		"maximum_score":          7.5,
+		"windows_cis_enabled":    true,
+		"cves_black_list.0":      "CVE-2021-44228",
+		"required_labels.#":      1,
+		"application_scopes.0":   "Global",
+		"kube_cis_enabled":       false,
+		"ignore_base_image_vln":  false,
+		"auto_scan_next_run":     "",
+		"policy_settings.0.warn": false,
+	} {
+		if actual := read.Get(attribute); actual != expected {
+			t.Errorf("expected %s to be %v, got %v", attribute, expected, actual)
+		}
+	}
+}
+
+// TestAssurancePolicySchemaCompatibility checks that the assurance policy resources and data sources still declare
+// the attributes they declared before being generated from assurancePolicyFields, with the same types
+func TestAssurancePolicySchemaCompatibility(t *testing.T) {
+	data, err := os.ReadFile("testdata/assurance_policy_schemas.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var recorded map[string]map[string]string
+	if err := json.Unmarshal(data, &recorded); err != nil {
+		t.Fatal(err)
+	}
+
+	provider := Provider(testVersion)
+	for name, attributes := range recorded {
+		var r *schema.Resource
+		if strings.HasPrefix(name, "data ") {
+			r = provider.DataSourcesMap[strings.TrimPrefix(name, "data ")]
+		} else {
+			r = provider.ResourcesMap[strings.TrimPrefix(name, "resource ")]
+		}
+		if r == nil {
+			t.Errorf("%s no longer exists", name)
+			continue
+		}
+		types := make(map[string]string)
+		collectAttributeTypes(r.Schema, "", types)
+		for attribute, attributeType := range attributes {
+			if types[attribute] != attributeType {
+				t.Errorf("%s: expected %s to be a %s, got %q", name, attribute, attributeType, types[attribute])
+			}
+		}
+	}
+}
+
+// collectAttributeTypes records the type of every attribute, the nested attributes prefixed by the name of their
+// block and the elements of lists, sets and maps of primitives named <attribute>.*
+func collectAttributeTypes(attributes map[string]*schema.Schema, prefix string, types map[string]string) {
+	for name, attribute := range attributes {
+		types[prefix+name] = attribute.Type.String()
+		switch elem := attribute.Elem.(type) {
+		case *schema.Resource:
+			collectAttributeTypes(elem.Schema, prefix+name+".", types)
+		case *schema.Schema:
+			types[prefix+name+".*"] = elem.Type.String()
+		}
+	}
+}
@@ -0,0 +1,317 @@
+package khulnasoft
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// autoScanIterationTypes are the iteration types of the auto scan schedule of assurance policies
+var autoScanIterationTypes = []string{"once", "daily", "weekly"}
+
+// autoScanWeekDays are the week days of weekly auto scan schedules, in the order of time.Weekday
+var autoScanWeekDays = []string{"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"}
+
+// autoScanTimeLayout is the layout of the time of day of the auto scan schedules, the console storing it in UTC
+const autoScanTimeLayout = "15:04"
+
+func autoScanScheduleSchema() *schema.Schema {
+	return &schema.Schema{
+		Type: schema.TypeList,
+		Description: "The schedule of the automatic rescans of the policy, replacing `auto_scan_time`. " +
+			"The schedule only fires when `auto_scan_enabled` is set.",
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"auto_scan_time"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"iteration_type": {
+					Type:         schema.TypeString,
+					Description:  "How often the rescans fire, either 'once', 'daily' or 'weekly'.",
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(autoScanIterationTypes, false),
+				},
+				"time": {
+					Type:         schema.TypeString,
+					Description:  "The time of day of the rescans, in the 24-hour HH:MM format, e.g. `02:30`.",
+					Required:     true,
+					ValidateFunc: validateAutoScanTime,
+				},
+				"time_zone": {
+					Type: schema.TypeString,
+					Description: "The IANA time zone of `time`, e.g. `Europe/Paris`. The console stores the time in UTC, converted with the offset of the time zone " +
+						"when the policy is applied, so a daylight saving time change shows as a diff that reapplies the local time.",
+					Optional:     true,
+					Default:      "UTC",
+					ValidateFunc: validateAutoScanTimeZone,
+				},
+				"iteration": {
+					Type:         schema.TypeInt,
+					Description:  "The number of days of daily schedules, or weeks of weekly schedules, between the rescans. Must be 1 for 'once' schedules.",
+					Optional:     true,
+					Default:      1,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"week_days": {
+					Type:        schema.TypeList,
+					Description: "The week days of weekly schedules, e.g. `monday`. Required for, and only allowed with, 'weekly' schedules.",
+					Optional:    true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice(autoScanWeekDays, false),
+					},
+				},
+			},
+		},
+	}
+}
+
+func autoScanNextRunSchema() *schema.Schema {
+	return &schema.Schema{
+		Type: schema.TypeString,
+		Description: "The next time the automatic rescans fire, in RFC3339 format, computed from the schedule of the console on read. " +
+			"Empty when auto scan is disabled, when a 'once' schedule already fired or when the schedule is not valid.",
+		Computed: true,
+	}
+}
+
+func validateAutoScanTime(v interface{}, k string) ([]string, []error) {
+	if _, err := time.Parse(autoScanTimeLayout, v.(string)); err != nil || len(v.(string)) != len(autoScanTimeLayout) {
+		return nil, []error{fmt.Errorf("%q must be a time of day in the HH:MM format, got %q", k, v)}
+	}
+	return nil, nil
+}
+
+func validateAutoScanTimeZone(v interface{}, k string) ([]string, []error) {
+	if _, err := time.LoadLocation(v.(string)); err != nil || v.(string) == "" {
+		return nil, []error{fmt.Errorf("%q must be an IANA time zone such as Europe/Paris, got %q", k, v)}
+	}
+	return nil, nil
+}
+
+// validateAutoScanSchedule checks the attributes of a schedule that only apply to some iteration types
+func validateAutoScanSchedule(iterationType string, iteration int, weekDays []string) error {
+	switch iterationType {
+	case "once":
+		if iteration != 1 {
+			return fmt.Errorf("auto_scan_schedule: iteration must be 1 for 'once' schedules, got %d", iteration)
+		}
+	case "weekly":
+		if len(weekDays) == 0 {
+			return fmt.Errorf("auto_scan_schedule: week_days must be set for 'weekly' schedules")
+		}
+	}
+	if iterationType != "weekly" && len(weekDays) > 0 {
+		return fmt.Errorf("auto_scan_schedule: week_days is only allowed for 'weekly' schedules")
+	}
+	for i, day := range weekDays {
+		if containsStringEntry(weekDays[:i], day) {
+			return fmt.Errorf("auto_scan_schedule: week day %q is listed more than once", day)
+		}
+	}
+	return nil
+}
+
+// autoScanScheduleCustomizeDiff validates the auto scan schedule, which must be enabled to fire
+func autoScanScheduleCustomizeDiff(d *schema.ResourceDiff) error {
+	if !d.NewValueKnown("auto_scan_schedule") {
+		return nil
+	}
+	schedules := d.Get("auto_scan_schedule").([]interface{})
+	if len(schedules) == 0 || schedules[0] == nil {
+		return nil
+	}
+	schedule := schedules[0].(map[string]interface{})
+	err := validateAutoScanSchedule(schedule["iteration_type"].(string), schedule["iteration"].(int), convertStringArr(schedule["week_days"].([]interface{})))
+	if err != nil {
+		return err
+	}
+	if d.NewValueKnown("auto_scan_enabled") && !d.Get("auto_scan_enabled").(bool) {
+		return fmt.Errorf("auto_scan_schedule is set but auto_scan_enabled is not, the scheduled rescans would never fire")
+	}
+	return nil
+}
+
+// expandAutoScanSchedule converts the schedule to the auto scan time of the console, whose time and week days are in
+// UTC. The time and time zone are validated by the schema.
+func expandAutoScanSchedule(schedule map[string]interface{}, now time.Time) client.ScanTimeAuto {
+	scanTime := client.ScanTimeAuto{
+		IterationType: schedule["iteration_type"].(string),
+		Time:          schedule["time"].(string),
+		Iteration:     schedule["iteration"].(int),
+		WeekDays:      schedule["week_days"].([]interface{}),
+	}
+	location, err := time.LoadLocation(schedule["time_zone"].(string))
+	if err != nil {
+		return scanTime
+	}
+	if hour, minute, err := parseAutoScanTime(scanTime.Time); err == nil {
+		local := now.In(location)
+		at := time.Date(local.Year(), local.Month(), local.Day(), hour, minute, 0, 0, location)
+		scanTime.Time = at.UTC().Format(autoScanTimeLayout)
+		scanTime.WeekDays = shiftWeekDays(scanTime.WeekDays, dayDifference(at, at.UTC()))
+	}
+	return scanTime
+}
+
+// flattenAutoScanSchedule converts the auto scan time of the console to the schedule, in the configured time zone
+func flattenAutoScanSchedule(d *schema.ResourceData, scanTime client.ScanTimeAuto, now time.Time) []map[string]interface{} {
+	timeZone := "UTC"
+	if v, ok := d.GetOk("auto_scan_schedule.0.time_zone"); ok {
+		timeZone = v.(string)
+	}
+	at := scanTime.Time
+	weekDays := scanTime.WeekDays
+	if location, err := time.LoadLocation(timeZone); err == nil {
+		if hour, minute, err := parseAutoScanTime(scanTime.Time); err == nil {
+			utc := now.UTC()
+			utcAt := time.Date(utc.Year(), utc.Month(), utc.Day(), hour, minute, 0, 0, time.UTC)
+			at = utcAt.In(location).Format(autoScanTimeLayout)
+			weekDays = shiftWeekDays(weekDays, dayDifference(utcAt, utcAt.In(location)))
+		}
+	} else {
+		timeZone = "UTC"
+	}
+
+	return []map[string]interface{}{
+		{
+			"iteration_type": scanTime.IterationType,
+			"time":           at,
+			"time_zone":      timeZone,
+			"iteration":      scanTime.Iteration,
+			"week_days":      weekDays,
+		},
+	}
+}
+
+// dayDifference returns the number of days between the dates of the same instant in two time zones
+func dayDifference(from, to time.Time) int {
+	fromDate := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	toDate := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(toDate.Sub(fromDate).Hours() / 24)
+}
+
+// shiftWeekDays moves the week days by a number of days, keeping the unknown days as is
+func shiftWeekDays(weekDays []interface{}, shift int) []interface{} {
+	shifted := make([]interface{}, len(weekDays))
+	for i, day := range weekDays {
+		shifted[i] = day
+		for j, name := range autoScanWeekDays {
+			if s, ok := day.(string); ok && strings.EqualFold(s, name) {
+				shifted[i] = autoScanWeekDays[((j+shift)%7+7)%7]
+			}
+		}
+	}
+	return shifted
+}
+
+func parseAutoScanTime(value string) (int, int, error) {
+	t, err := time.Parse(autoScanTimeLayout, value)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid auto scan time %q, expected HH:MM", value)
+	}
+	return t.Hour(), t.Minute(), nil
+}
+
+// parseAutoScanAnchor parses the last update of a policy, from which the iterations of its schedule are counted,
+// either an RFC3339 timestamp or a unix time in seconds
+func parseAutoScanAnchor(lastupdate string) (time.Time, bool) {
+	if t, err := time.Parse(time.RFC3339, lastupdate); err == nil {
+		return t, true
+	}
+	if seconds, err := strconv.ParseInt(lastupdate, 10, 64); err == nil && seconds > 0 {
+		return time.Unix(seconds, 0), true
+	}
+	return time.Time{}, false
+}
+
+// nextAutoScanRun returns the first run of the auto scan schedule at or after now. The runs start at the first
+// scheduled time at or after anchor, the last update of the policy, and repeat every iteration days or weeks; a
+// weekly schedule runs on its week days of every iteration-th week, weeks starting on Monday.
+func nextAutoScanRun(scanTime client.ScanTimeAuto, anchor, now time.Time) (time.Time, bool) {
+	hour, minute, err := parseAutoScanTime(scanTime.Time)
+	if err != nil {
+		return time.Time{}, false
+	}
+	var weekDays []string
+	for _, day := range scanTime.WeekDays {
+		if s, ok := day.(string); ok {
+			weekDays = append(weekDays, strings.ToLower(s))
+		}
+	}
+	iteration := scanTime.Iteration
+	if iteration < 1 {
+		iteration = 1
+	}
+	if validateAutoScanSchedule(scanTime.IterationType, iteration, weekDays) != nil {
+		return time.Time{}, false
+	}
+
+	anchor = anchor.UTC()
+	first := time.Date(anchor.Year(), anchor.Month(), anchor.Day(), hour, minute, 0, 0, time.UTC)
+	if first.Before(anchor) {
+		first = first.AddDate(0, 0, 1)
+	}
+
+	switch scanTime.IterationType {
+	case "once":
+		if first.Before(now) {
+			return time.Time{}, false
+		}
+		return first, true
+	case "daily":
+		run := first
+		if run.Before(now) {
+			// skip the whole iterations before now
+			days := int(now.Sub(run).Hours() / 24)
+			run = run.AddDate(0, 0, days-days%iteration)
+			for run.Before(now) {
+				run = run.AddDate(0, 0, iteration)
+			}
+		}
+		return run, true
+	case "weekly":
+		firstWeek := startOfWeek(first)
+		day := first
+		if day.Before(now) {
+			day = time.Date(now.Year(), now.Month(), now.Day(), hour, minute, 0, 0, time.UTC)
+			if day.Before(now) {
+				day = day.AddDate(0, 0, 1)
+			}
+		}
+		for i := 0; i < 7*iteration; i++ {
+			weeks := int(startOfWeek(day).Sub(firstWeek).Hours() / (24 * 7))
+			if weeks%iteration == 0 && containsStringEntry(weekDays, autoScanWeekDays[day.Weekday()]) {
+				return day, true
+			}
+			day = day.AddDate(0, 0, 1)
+		}
+	}
+	return time.Time{}, false
+}
+
+func startOfWeek(t time.Time) time.Time {
+	days := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-days, 0, 0, 0, 0, time.UTC)
+}
+
+// autoScanNextRun returns the next run of the auto scan of a policy as an RFC3339 timestamp, if any
+func autoScanNextRun(iap *client.AssurancePolicy, now time.Time) string {
+	if !iap.AutoScanEnabled {
+		return ""
+	}
+	anchor, ok := parseAutoScanAnchor(iap.Lastupdate)
+	if !ok {
+		anchor = now
+	}
+	next, ok := nextAutoScanRun(iap.AutoScanTime, anchor, now)
+	if !ok {
+		return ""
+	}
+	return next.Format(time.RFC3339)
+}
@@ -0,0 +1,136 @@
+package khulnasoft
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestValidateAutoScanSchedule(t *testing.T) {
+	cases := []struct {
+		iterationType string
+		iteration     int
+		weekDays      []string
+		err           string
+	}{
+		{iterationType: "once", iteration: 1},
+		{iterationType: "daily", iteration: 3},
+		{iterationType: "weekly", iteration: 2, weekDays: []string{"monday", "thursday"}},
+		{iterationType: "once", iteration: 2, err: "iteration must be 1 for 'once' schedules"},
+		{iterationType: "weekly", iteration: 1, err: "week_days must be set for 'weekly' schedules"},
+		{iterationType: "daily", iteration: 1, weekDays: []string{"monday"}, err: "week_days is only allowed for 'weekly' schedules"},
+		{iterationType: "weekly", iteration: 1, weekDays: []string{"monday", "monday"}, err: `week day "monday" is listed more than once`},
+	}
+
+	for _, tc := range cases {
+		err := validateAutoScanSchedule(tc.iterationType, tc.iteration, tc.weekDays)
+		if tc.err == "" {
+			if err != nil {
+				t.Errorf("%s: expected no error, got %v", tc.iterationType, err)
+			}
+		} else if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("%s: expected an error containing %q, got %v", tc.iterationType, tc.err, err)
+		}
+	}
+
+	for _, value := range []string{"24:00", "8:30", "08:30:00", "noon"} {
+		if _, errs := validateAutoScanTime(value, "time"); len(errs) == 0 {
+			t.Errorf("expected an error for the time %q", value)
+		}
+	}
+	if _, errs := validateAutoScanTimeZone("Mars/Olympus_Mons", "time_zone"); len(errs) == 0 {
+		t.Error("expected an error for an unknown time zone")
+	}
+}
+
+func TestAutoScanScheduleTimeZone(t *testing.T) {
+	// 00:30 in Tokyo is 15:30 UTC the day before
+	now := time.Date(2024, 6, 12, 12, 0, 0, 0, time.UTC)
+	schedule := map[string]interface{}{
+		"iteration_type": "weekly",
+		"time":           "00:30",
+		"time_zone":      "Asia/Tokyo",
+		"iteration":      1,
+		"week_days":      []interface{}{"monday", "sunday"},
+	}
+	scanTime := expandAutoScanSchedule(schedule, now)
+	expected := client.ScanTimeAuto{IterationType: "weekly", Time: "15:30", Iteration: 1, WeekDays: []interface{}{"sunday", "saturday"}}
+	if !reflect.DeepEqual(scanTime, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, scanTime)
+	}
+
+	d := schema.TestResourceDataRaw(t, assurancePolicySchema(client.AssuranceTypeImage), map[string]interface{}{
+		"name":               "test",
+		"application_scopes": []interface{}{"Global"},
+		"auto_scan_schedule": []interface{}{schedule},
+	})
+	flattened := flattenAutoScanSchedule(d, scanTime, now)[0]
+	for key, value := range schedule {
+		if !reflect.DeepEqual(flattened[key], value) {
+			t.Errorf("expected %s to be %v, got %v", key, value, flattened[key])
+		}
+	}
+}
+
+func TestNextAutoScanRun(t *testing.T) {
+	anchor := time.Date(2024, 6, 3, 10, 0, 0, 0, time.UTC) // a Monday
+	cases := []struct {
+		name     string
+		scanTime client.ScanTimeAuto
+		now      time.Time
+		next     string
+	}{
+		{
+			name:     "once before it fires",
+			scanTime: client.ScanTimeAuto{IterationType: "once", Time: "02:00", Iteration: 1},
+			now:      anchor.Add(time.Hour),
+			next:     "2024-06-04T02:00:00Z",
+		},
+		{
+			name:     "once after it fired",
+			scanTime: client.ScanTimeAuto{IterationType: "once", Time: "02:00", Iteration: 1},
+			now:      anchor.AddDate(0, 0, 2),
+		},
+		{
+			name:     "every 3 days",
+			scanTime: client.ScanTimeAuto{IterationType: "daily", Time: "12:00", Iteration: 3},
+			now:      time.Date(2024, 6, 5, 13, 0, 0, 0, time.UTC),
+			next:     "2024-06-06T12:00:00Z",
+		},
+		{
+			name:     "every other week",
+			scanTime: client.ScanTimeAuto{IterationType: "weekly", Time: "08:00", Iteration: 2, WeekDays: []interface{}{"wednesday"}},
+			now:      time.Date(2024, 6, 6, 0, 0, 0, 0, time.UTC),
+			next:     "2024-06-19T08:00:00Z",
+		},
+		{
+			name:     "weekly without week days",
+			scanTime: client.ScanTimeAuto{IterationType: "weekly", Time: "08:00", Iteration: 1},
+			now:      anchor,
+		},
+		{
+			name:     "unknown iteration type",
+			scanTime: client.ScanTimeAuto{IterationType: "none", Time: "08:00"},
+			now:      anchor,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			next, ok := nextAutoScanRun(tc.scanTime, anchor, tc.now)
+			if tc.next == "" {
+				if ok {
+					t.Errorf("expected no next run, got %v", next)
+				}
+				return
+			}
+			if !ok || next.Format(time.RFC3339) != tc.next {
+				t.Errorf("expected the next run %s, got %v (%v)", tc.next, next, ok)
+			}
+		})
+	}
+}
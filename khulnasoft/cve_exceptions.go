@@ -0,0 +1,141 @@
+package khulnasoft
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// cveIdPattern matches CVE identifiers, whose sequence number has at least 4 digits
+var cveIdPattern = regexp.MustCompile(`^CVE-\d{4}-\d{4,}$`)
+
+func cveExceptionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type: schema.TypeList,
+		Description: "Allowed CVEs with the reason of the exception, added to `cves_white_list` at plan time. Requires `cves_white_list_enabled`. " +
+			"Once `expires_at` is past, the CVE is dropped from `cves_white_list` on the next plan, unless also configured there.",
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"cve": {
+					Type:         schema.TypeString,
+					Description:  "The allowed CVE, e.g. `CVE-2021-44228`.",
+					Required:     true,
+					ValidateFunc: validateCveId,
+				},
+				"justification": {
+					Type:         schema.TypeString,
+					Description:  "Why the CVE is allowed, e.g. the mitigation in place.",
+					Required:     true,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+				"owner": {
+					Type:        schema.TypeString,
+					Description: "Who is accountable for the exception.",
+					Optional:    true,
+				},
+				"expires_at": {
+					Type:         schema.TypeString,
+					Description:  "An RFC3339 timestamp after which the CVE is no longer allowed. The exception never expires when not set.",
+					Optional:     true,
+					ValidateFunc: validation.IsRFC3339Time,
+				},
+			},
+		},
+	}
+}
+
+func validateCveId(v interface{}, k string) ([]string, []error) {
+	if !cveIdPattern.MatchString(v.(string)) {
+		return nil, []error{fmt.Errorf("%s must be a CVE identifier such as CVE-2021-44228, got %q", k, v)}
+	}
+	return nil, nil
+}
+
+// isCveExceptionExpired returns whether the exception expired at the given time
+func isCveExceptionExpired(exception map[string]interface{}, now time.Time) bool {
+	expiresAt, err := time.Parse(time.RFC3339, exception["expires_at"].(string))
+	if err != nil {
+		return false
+	}
+	return !expiresAt.After(now)
+}
+
+// mergeCveExceptions adds the CVEs of the exceptions that did not expire at the given time to the CVE white list
+func mergeCveExceptions(whiteList []string, exceptions []interface{}, now time.Time) []string {
+	merged := append([]string{}, whiteList...)
+	for _, exception := range exceptions {
+		exception := exception.(map[string]interface{})
+		cve := exception["cve"].(string)
+		if isCveExceptionExpired(exception, now) {
+			log.Printf("[INFO] the exception of %s expired at %s, %s is no longer allowed", cve, exception["expires_at"], cve)
+			continue
+		}
+		if !containsStringEntry(merged, cve) {
+			merged = append(merged, cve)
+		}
+	}
+	return merged
+}
+
+// validateCveExceptions checks that each CVE has a single exception
+func validateCveExceptions(exceptions []interface{}) error {
+	seen := make(map[string]bool, len(exceptions))
+	for _, exception := range exceptions {
+		cve := exception.(map[string]interface{})["cve"].(string)
+		if seen[cve] {
+			return fmt.Errorf("cve_exceptions: %s has more than one exception", cve)
+		}
+		seen[cve] = true
+	}
+	return nil
+}
+
+// checkCveExceptionsEnabled reports exceptions configured while the CVE white list is disabled, whose CVEs would
+// not be allowed
+func checkCveExceptionsEnabled(exceptions []interface{}, enabled bool) error {
+	if len(exceptions) > 0 && !enabled {
+		return fmt.Errorf("cve_exceptions: the CVEs are only allowed when cves_white_list_enabled is true")
+	}
+	return nil
+}
+
+// cveExceptionsCustomizeDiff adds the CVEs of the exceptions that did not expire to the CVE white list, so that
+// the plan shows the CVEs whose exception expired being removed
+func cveExceptionsCustomizeDiff(d *schema.ResourceDiff) error {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+	if !rawConfig.GetAttr("cve_exceptions").IsWhollyKnown() || !rawConfig.GetAttr("cves_white_list").IsWhollyKnown() {
+		return nil
+	}
+	exceptions := d.Get("cve_exceptions").([]interface{})
+	if err := validateCveExceptions(exceptions); err != nil {
+		return err
+	}
+	// the value of a base policy is only known once the base policy is read, when the policy is built
+	if enabled := rawConfig.GetAttr("cves_white_list_enabled"); enabled.IsKnown() && (!enabled.IsNull() || d.Get("base_policy").(string) == "") {
+		if err := checkCveExceptionsEnabled(exceptions, d.Get("cves_white_list_enabled").(bool)); err != nil {
+			return err
+		}
+	}
+	// the CVEs of a base policy are kept unless the list is configured
+	if d.Get("base_policy").(string) != "" && !isAttributeConfigured(rawConfig, "cves_white_list") && !isAttributeConfigured(rawConfig, "cve_exceptions") {
+		return nil
+	}
+
+	var cves []string
+	if v := rawConfig.GetAttr("cves_white_list"); !v.IsNull() {
+		for _, cve := range v.AsValueSlice() {
+			if !cve.IsNull() {
+				cves = append(cves, cve.AsString())
+			}
+		}
+	}
+	return d.SetNew("cves_white_list", mergeCveExceptions(cves, exceptions, time.Now()))
+}
@@ -0,0 +1,69 @@
+package khulnasoft
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestValidateCveId(t *testing.T) {
+	for _, valid := range []string{"CVE-2021-44228", "CVE-1999-0001", "CVE-2024-1234567"} {
+		if _, errs := validateCveId(valid, "cve"); len(errs) != 0 {
+			t.Errorf("expected %q to be valid, got %v", valid, errs)
+		}
+	}
+	for _, invalid := range []string{"cve-2021-44228", "CVE-2021-123", "CVE-21-44228", "GHSA-jfh8-c2jp-5v3q", " CVE-2021-44228", ""} {
+		if _, errs := validateCveId(invalid, "cve"); len(errs) == 0 {
+			t.Errorf("expected %q to be rejected", invalid)
+		}
+	}
+}
+
+func TestMergeCveExceptions(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	exceptions := []interface{}{
+		map[string]interface{}{"cve": "CVE-2021-44228", "justification": "not reachable", "owner": "", "expires_at": "2026-12-31T00:00:00Z"},
+		map[string]interface{}{"cve": "CVE-2022-22965", "justification": "patched", "owner": "security", "expires_at": "2026-10-19T12:00:00Z"},
+		map[string]interface{}{"cve": "CVE-2023-44487", "justification": "mitigated", "owner": "", "expires_at": ""},
+		map[string]interface{}{"cve": "CVE-2020-1472", "justification": "listed", "owner": "", "expires_at": "2020-01-01T00:00:00Z"},
+	}
+
+	merged := mergeCveExceptions([]string{"CVE-2020-1472"}, exceptions, now)
+	expected := []string{"CVE-2020-1472", "CVE-2021-44228", "CVE-2023-44487"}
+	if !reflect.DeepEqual(merged, expected) {
+		t.Errorf("expected %v, got %v", expected, merged)
+	}
+
+	if err := validateCveExceptions(exceptions); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if err := validateCveExceptions(append(exceptions, exceptions[0])); err == nil {
+		t.Error("expected a CVE with two exceptions to be rejected")
+	}
+	if err := checkCveExceptionsEnabled(exceptions, false); err == nil {
+		t.Error("expected exceptions to be rejected when the CVE white list is disabled")
+	}
+	if err := checkCveExceptionsEnabled(nil, false); err != nil {
+		t.Errorf("expected no error without exceptions, got %v", err)
+	}
+}
+
+func TestExpandCveExceptions(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, assurancePolicySchema(client.AssuranceTypeImage), map[string]interface{}{
+		"name":            "production",
+		"cves_white_list": []interface{}{"CVE-2021-44228"},
+		"cve_exceptions": []interface{}{
+			map[string]interface{}{"cve": "CVE-2021-44228", "justification": "not reachable"},
+			map[string]interface{}{"cve": "CVE-2023-44487", "justification": "mitigated", "owner": "platform", "expires_at": "2999-01-01T00:00:00Z"},
+			map[string]interface{}{"cve": "CVE-2022-22965", "justification": "patched", "expires_at": "2020-01-01T00:00:00Z"},
+		},
+	})
+	iap := expandAssurancePolicy(d, client.AssuranceTypeImage)
+	expected := []string{"CVE-2021-44228", "CVE-2023-44487"}
+	if !reflect.DeepEqual(iap.CvesWhiteList, expected) {
+		t.Errorf("expected %v, got %v", expected, iap.CvesWhiteList)
+	}
+}
@@ -0,0 +1,34 @@
+package khulnasoft
+
+import (
+	"context"
+
+	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataAssurancePolicy returns the assurance policy data source of the given type, exposing the attributes of the
+// resource read from the console
+func dataAssurancePolicy(assuranceType client.AssuranceType) *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataAssurancePolicyRead(assuranceType),
+		Schema:      assurancePolicyDataSchema(assuranceType),
+	}
+}
+
+func dataAssurancePolicyRead(assuranceType client.AssuranceType) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		ac := m.(*client.Client)
+		name := d.Get("name").(string)
+
+		iap, err := ac.GetAssurancePolicy(name, string(assuranceType))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		flattenAssurancePolicyData(d, iap, assuranceType)
+		d.SetId(name)
+		return nil
+	}
+}
@@ -0,0 +1,398 @@
+package khulnasoft
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// vulnerabilitySeverities are the vulnerability severities, lowest first
+var vulnerabilitySeverities = []string{"negligible", "low", "medium", "high", "critical"}
+
+func dataAssurancePolicySimulation() *schema.Resource {
+	policySchema := make(map[string]*schema.Schema)
+	for k, v := range assurancePolicySchema(client.AssuranceTypeImage) {
+		attribute := *v
+		policySchema[k] = &attribute
+	}
+	policySchema["name"].Required = false
+	policySchema["name"].Optional = true
+	// the policy is not scheduled
+	delete(policySchema, "auto_scan_next_run")
+
+	policySchema["image"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Scanned images the policy is evaluated against.",
+		Required:    true,
+		MinItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"registry": {
+					Type:        schema.TypeString,
+					Description: "Name of the registry of the image.",
+					Required:    true,
+				},
+				"repository": {
+					Type:        schema.TypeString,
+					Description: "Repository of the image.",
+					Required:    true,
+				},
+				"tag": {
+					Type:        schema.TypeString,
+					Description: "Tag of the image.",
+					Required:    true,
+				},
+			},
+		},
+	}
+	policySchema["results"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Result of the evaluation of the policy for each image, in the order of `image`.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"registry": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"repository": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"tag": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"passed": {
+					Type:        schema.TypeBool,
+					Description: "Indicates if the image passes the policy.",
+					Computed:    true,
+				},
+				"blocked": {
+					Type:        schema.TypeBool,
+					Description: "Indicates if the image would be blocked, failing a policy that blocks failed images.",
+					Computed:    true,
+				},
+				"failed_controls": {
+					Type:        schema.TypeList,
+					Description: "Controls of the policy the image fails, e.g. `cvss_severity` or `packages_black_list`.",
+					Computed:    true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"reasons": {
+					Type:        schema.TypeList,
+					Description: "Why the image fails each control.",
+					Computed:    true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+	policySchema["failed_images"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Images failing the policy, as `registry/repository:tag`.",
+		Computed:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+
+	return &schema.Resource{
+		Description: "The data source `khulnasoft_assurance_policy_simulation` evaluates an image assurance policy, with the attributes of `khulnasoft_image_assurance_policy`, " +
+			"against already scanned images without creating it, to know what the policy would fail or block before enforcing it. " +
+			"The policy is evaluated by the provider from the vulnerabilities and metadata of the images: its vulnerability severity and score thresholds, " +
+			"CVE and package lists, licenses and required and forbidden labels. Packages are known from their vulnerabilities, " +
+			"so the package and license controls only consider the vulnerable packages of the images.",
+		ReadContext: dataAssurancePolicySimulationRead,
+		Schema:      policySchema,
+	}
+}
+
+func dataAssurancePolicySimulationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+	assurance_type := "image"
+
+	iap := expandAssurancePolicy(d, client.AssuranceTypeImage)
+	if err := applyBaseAssurancePolicy(d, c, iap, assurance_type); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := checkCveExceptionsEnabled(d.Get("cve_exceptions").([]interface{}), iap.CvesWhiteListEnabled); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := applyPackageListFiles(d, iap); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := applyTrustedBaseImageSets(d, iap); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var results []map[string]interface{}
+	var names, failedImages []string
+	now := time.Now()
+	for _, v := range d.Get("image").([]interface{}) {
+		i := v.(map[string]interface{})
+		name := fmt.Sprintf("%v/%v:%v", i["registry"], i["repository"], i["tag"])
+		image, err := c.GetImage(fmt.Sprintf("%v/%v/%v", i["registry"], i["repository"], i["tag"]))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		vulnerabilities, err := c.GetVulnerabilities(image)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		failedControls, reasons := simulateAssurancePolicy(iap, image, vulnerabilities, now)
+		passed := len(failedControls) == 0
+		if !passed {
+			failedImages = append(failedImages, name)
+		}
+		names = append(names, name)
+		results = append(results, map[string]interface{}{
+			"registry":        image.Registry,
+			"repository":      image.Repository,
+			"tag":             image.Tag,
+			"passed":          passed,
+			"blocked":         !passed && iap.BlockFailed,
+			"failed_controls": failedControls,
+			"reasons":         reasons,
+		})
+	}
+
+	if err := d.Set("results", results); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("failed_images", failedImages)
+	d.SetId(fmt.Sprintf("%s,%s", iap.Name, strings.Join(names, ",")))
+
+	return nil
+}
+
+// simulateAssurancePolicy evaluates the controls of an image assurance policy against an image and its
+// vulnerabilities, and returns the controls the image fails with the reasons
+func simulateAssurancePolicy(iap *client.AssurancePolicy, image *client.Image, vulnerabilities []client.Vulnerabilities, now time.Time) ([]string, []string) {
+	var failedControls, reasons []string
+	fail := func(control, reason string, args ...interface{}) {
+		if len(failedControls) == 0 || failedControls[len(failedControls)-1] != control {
+			failedControls = append(failedControls, control)
+		}
+		reasons = append(reasons, fmt.Sprintf(control+": "+reason, args...))
+	}
+
+	var considered []client.Vulnerabilities
+	for _, v := range vulnerabilities {
+		if iap.CvesWhiteListEnabled && containsStringEntry(iap.CvesWhiteList, v.Name) {
+			continue
+		}
+		if iap.IgnoreRecentlyPublishedVln && isRecentlyPublished(v, iap.IgnoreRecentlyPublishedVlnPeriod, now) {
+			continue
+		}
+		considered = append(considered, v)
+	}
+
+	if iap.CvssSeverityEnabled && iap.CvssSeverity != "" {
+		threshold := severityRank(iap.CvssSeverity)
+		for _, v := range considered {
+			if iap.CvssSeverityExcludeNoFix && v.FixVersion == "" {
+				continue
+			}
+			if severity := vulnerabilitySeverity(v); severityRank(severity) >= threshold {
+				fail("cvss_severity", "%s of %s has severity %s, %s or higher is not allowed", v.Name, vulnerablePackage(v), severity, iap.CvssSeverity)
+			}
+		}
+	}
+
+	if iap.MaximumScoreEnabled {
+		for _, v := range considered {
+			if iap.MaximumScoreExcludeNoFix && v.FixVersion == "" {
+				continue
+			}
+			if score := vulnerabilityScore(v); score > iap.MaximumScore {
+				fail("maximum_score", "%s of %s has score %.1f, above the maximum score %.1f", v.Name, vulnerablePackage(v), score, iap.MaximumScore)
+			}
+		}
+	}
+
+	if iap.CvesBlackListEnabled {
+		for _, v := range considered {
+			if containsStringEntry(iap.CvesBlackList, v.Name) {
+				fail("cves_black_list", "%s of %s is in the CVE deny list", v.Name, vulnerablePackage(v))
+			}
+		}
+	}
+
+	packages := vulnerablePackages(vulnerabilities)
+	if iap.PackagesBlackListEnabled {
+		for _, p := range packages {
+			if matchPackageList(iap.PackagesBlackList, p) {
+				fail("packages_black_list", "package %s %s is in the package deny list", p.Name, p.Version)
+			}
+		}
+	}
+	if iap.PackagesWhiteListEnabled {
+		for _, p := range packages {
+			if !matchPackageList(iap.PackagesWhiteList, p) {
+				fail("packages_white_list", "package %s %s is not in the package allow list", p.Name, p.Version)
+			}
+		}
+	}
+
+	if iap.BlacklistedLicensesEnabled {
+		blackList := newLicenseMatcher(iap.BlacklistedLicenses)
+		for _, p := range packages {
+			for _, license := range p.Licenses {
+				if blackList.denied(license) {
+					fail("blacklisted_licenses", "package %s %s has the denied license %s", p.Name, p.Version, license)
+				}
+			}
+		}
+	}
+	if iap.WhitelistedLicensesEnabled {
+		whiteList := newLicenseMatcher(iap.WhitelistedLicenses)
+		for _, p := range packages {
+			for _, license := range p.Licenses {
+				if !whiteList.allowed(license) {
+					fail("whitelisted_licenses", "package %s %s has the license %s, which is not allowed", p.Name, p.Version, license)
+				}
+			}
+		}
+	}
+
+	labels := imageLabels(image)
+	if iap.RequiredLabelsEnabled {
+		for _, l := range iap.RequiredLabels {
+			if !matchImageLabel(labels, l) {
+				fail("required_labels", "label %s is missing", formatImageLabel(l))
+			}
+		}
+	}
+	if iap.ForbiddenLabelsEnabled {
+		for _, l := range iap.ForbiddenLabels {
+			if matchImageLabel(labels, l) {
+				fail("forbidden_labels", "label %s is forbidden", formatImageLabel(l))
+			}
+		}
+	}
+
+	return failedControls, reasons
+}
+
+func severityRank(severity string) int {
+	for i, s := range vulnerabilitySeverities {
+		if strings.EqualFold(s, severity) {
+			return i
+		}
+	}
+	return -1
+}
+
+// vulnerabilitySeverity returns the severity of a vulnerability, preferring the Khulnasoft severity over the NVD and
+// vendor ones
+func vulnerabilitySeverity(v client.Vulnerabilities) string {
+	for _, severity := range []string{v.KhulnasoftSeverity, v.NvdCvss3Severity, v.NvdSeverity, v.VendorSeverity} {
+		if severity != "" {
+			return strings.ToLower(severity)
+		}
+	}
+	return ""
+}
+
+// vulnerabilityScore returns the score of a vulnerability, preferring the Khulnasoft score over the NVD and vendor
+// ones
+func vulnerabilityScore(v client.Vulnerabilities) float64 {
+	for _, score := range []float64{v.KhulnasoftScore, v.NvdCvss3Score, v.NvdCvss2Score, v.VendorCvss2Score} {
+		if score != 0 {
+			return score
+		}
+	}
+	return 0
+}
+
+func isRecentlyPublished(v client.Vulnerabilities, days int, now time.Time) bool {
+	for _, layout := range []string{time.RFC3339, "2006-01-02"} {
+		if published, err := time.Parse(layout, v.PublishDate); err == nil {
+			return now.Sub(published) < time.Duration(days)*24*time.Hour
+		}
+	}
+	return false
+}
+
+func vulnerablePackage(v client.Vulnerabilities) string {
+	if v.Resource.Version == "" {
+		return v.Resource.Name
+	}
+	return v.Resource.Name + " " + v.Resource.Version
+}
+
+// vulnerablePackages returns the distinct packages of the vulnerabilities
+func vulnerablePackages(vulnerabilities []client.Vulnerabilities) []client.Resource {
+	var packages []client.Resource
+	seen := make(map[string]bool)
+	for _, v := range vulnerabilities {
+		key := strings.Join([]string{v.Resource.Format, v.Resource.Name, v.Resource.Version, v.Resource.Arch}, "\x00")
+		if v.Resource.Name == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		packages = append(packages, v.Resource)
+	}
+	return packages
+}
+
+// matchPackageList reports whether a package matches an entry of a package list, the attributes not set in the entry
+// matching any package
+func matchPackageList(list []client.ListPackages, p client.Resource) bool {
+	for _, entry := range list {
+		if entry.Name != p.Name {
+			continue
+		}
+		if entry.Format != "" && !strings.EqualFold(entry.Format, p.Format) {
+			continue
+		}
+		if entry.Arch != "" && entry.Arch != p.Arch {
+			continue
+		}
+		if entry.Version != "" && !matchScopeValue(entry.Version, p.Version) {
+			continue
+		}
+		if entry.VersionRange != "" && !matchPackageVersionRange(entry.VersionRange, p.Version) {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// imageLabels returns the labels of an image, its docker labels by key and its Khulnasoft labels with an empty value
+func imageLabels(image *client.Image) map[string]string {
+	labels := make(map[string]string)
+	for _, label := range image.Labels {
+		labels[label] = ""
+	}
+	for _, label := range image.Metadata.DockerLabels {
+		key, value, _ := strings.Cut(label, "=")
+		labels[key] = value
+	}
+	return labels
+}
+
+// matchImageLabel reports whether an image has a label, a label of the policy without value matching any value
+func matchImageLabel(labels map[string]string, l client.Labels) bool {
+	value, ok := labels[l.Key]
+	return ok && (l.Value == "" || matchScopeValue(l.Value, value))
+}
+
+func formatImageLabel(l client.Labels) string {
+	if l.Value == "" {
+		return l.Key
+	}
+	return l.Key + "=" + l.Value
+}
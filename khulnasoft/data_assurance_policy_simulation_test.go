@@ -0,0 +1,204 @@
+package khulnasoft
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestMatchPackageVersionRange(t *testing.T) {
+	cases := []struct {
+		versionRange string
+		version      string
+		match        bool
+	}{
+		{">=1.2.0, <2.0.0", "1.10.3", true},
+		{">=1.2.0, <2.0.0", "2.0.0", false},
+		{"<1.0 || >=3.0", "3.1", true},
+		{"<1.0 || >=3.0", "2.0", false},
+		{"1.2.*", "1.2.7", true},
+		{"!=1.2.3", "1.2.3", false},
+		{"~1.2.3", "1.2.9", true},
+		{"~1.2.3", "1.3.0", false},
+		{"^1.2", "1.9.0", true},
+		{"^1.2", "2.0.0", false},
+	}
+
+	for _, tc := range cases {
+		if match := matchPackageVersionRange(tc.versionRange, tc.version); match != tc.match {
+			t.Errorf("expected %s in %q to be %v, got %v", tc.version, tc.versionRange, tc.match, match)
+		}
+	}
+}
+
+func TestSimulateAssurancePolicy(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	image := &client.Image{
+		Labels:   []string{"production"},
+		Metadata: client.Metadata{DockerLabels: []string{"maintainer=platform", "org.opencontainers.image.source=github.com/acme/app"}},
+	}
+	vulnerabilities := []client.Vulnerabilities{
+		{
+			Name:               "CVE-2023-0001",
+			KhulnasoftSeverity: "high",
+			KhulnasoftScore:    8.1,
+			FixVersion:         "1.1.1l",
+			PublishDate:        "2023-01-10T00:00:00Z",
+			Resource:           client.Resource{Format: "rpm", Name: "openssl", Version: "1.1.1k", Licenses: []string{"OpenSSL"}},
+		},
+		{
+			Name:             "CVE-2024-0002",
+			NvdCvss3Severity: "critical",
+			NvdCvss3Score:    9.8,
+			PublishDate:      "2024-05-25",
+			Resource:         client.Resource{Format: "npm", Name: "lodash", Version: "4.17.20", Licenses: []string{"MIT"}},
+		},
+	}
+
+	cases := []struct {
+		name           string
+		policy         client.AssurancePolicy
+		failedControls []string
+	}{
+		{
+			name:   "no controls",
+			policy: client.AssurancePolicy{},
+		},
+		{
+			name:           "severity",
+			policy:         client.AssurancePolicy{CvssSeverityEnabled: true, CvssSeverity: "high"},
+			failedControls: []string{"cvss_severity"},
+		},
+		{
+			name:   "severity without fix excluded",
+			policy: client.AssurancePolicy{CvssSeverityEnabled: true, CvssSeverity: "critical", CvssSeverityExcludeNoFix: true},
+		},
+		{
+			name:   "recently published ignored",
+			policy: client.AssurancePolicy{MaximumScoreEnabled: true, MaximumScore: 9, IgnoreRecentlyPublishedVln: true, IgnoreRecentlyPublishedVlnPeriod: 30},
+		},
+		{
+			name:           "maximum score",
+			policy:         client.AssurancePolicy{MaximumScoreEnabled: true, MaximumScore: 8},
+			failedControls: []string{"maximum_score"},
+		},
+		{
+			name:   "allowed cve",
+			policy: client.AssurancePolicy{CvesBlackListEnabled: true, CvesBlackList: []string{"CVE-2023-0001"}, CvesWhiteListEnabled: true, CvesWhiteList: []string{"CVE-2023-0001"}},
+		},
+		{
+			name:           "denied cve",
+			policy:         client.AssurancePolicy{CvesBlackListEnabled: true, CvesBlackList: []string{"CVE-2023-0001"}},
+			failedControls: []string{"cves_black_list"},
+		},
+		{
+			name: "package lists",
+			policy: client.AssurancePolicy{
+				PackagesBlackListEnabled: true,
+				PackagesBlackList:        []client.ListPackages{{Format: "npm", Name: "lodash", VersionRange: "<4.17.21"}},
+				PackagesWhiteListEnabled: true,
+				PackagesWhiteList:        []client.ListPackages{{Name: "lodash"}},
+			},
+			failedControls: []string{"packages_black_list", "packages_white_list"},
+		},
+		{
+			name: "licenses",
+			policy: client.AssurancePolicy{
+				BlacklistedLicensesEnabled: true,
+				BlacklistedLicenses:        []string{"GPL-3.0"},
+				WhitelistedLicensesEnabled: true,
+				WhitelistedLicenses:        []string{"MIT"},
+			},
+			failedControls: []string{"whitelisted_licenses"},
+		},
+		{
+			name: "license expressions",
+			policy: client.AssurancePolicy{
+				BlacklistedLicensesEnabled: true,
+				BlacklistedLicenses:        []string{"OpenSSL AND MIT"},
+				WhitelistedLicensesEnabled: true,
+				WhitelistedLicenses:        []string{"mit", "OpenSSL"},
+			},
+		},
+		{
+			name: "labels",
+			policy: client.AssurancePolicy{
+				RequiredLabelsEnabled:  true,
+				RequiredLabels:         []client.Labels{{Key: "maintainer", Value: "plat*"}, {Key: "team"}},
+				ForbiddenLabelsEnabled: true,
+				ForbiddenLabels:        []client.Labels{{Key: "production"}},
+			},
+			failedControls: []string{"required_labels", "forbidden_labels"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			failedControls, reasons := simulateAssurancePolicy(&tc.policy, image, vulnerabilities, now)
+			if !reflect.DeepEqual(failedControls, tc.failedControls) {
+				t.Errorf("expected failed controls %v, got %v (%v)", tc.failedControls, failedControls, reasons)
+			}
+			if len(failedControls) > 0 && len(reasons) < len(failedControls) {
+				t.Errorf("expected a reason for each failed control, got %v", reasons)
+			}
+		})
+	}
+}
+
+func TestDataSourceKhulnasoftAssurancePolicySimulation(t *testing.T) {
+	t.Parallel()
+	image := client.Image{
+		Registry:   acctest.RandomWithPrefix("terraform-test"),
+		Repository: "alpine",
+		Tag:        "3.13",
+	}
+	rootRef := "data.khulnasoft_assurance_policy_simulation.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssurancePolicySimulation(&image),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rootRef, "results.#", "1"),
+					resource.TestCheckResourceAttr(rootRef, "results.0.repository", image.Repository),
+					resource.TestCheckResourceAttr(rootRef, "results.0.passed", "false"),
+					resource.TestCheckResourceAttr(rootRef, "results.0.blocked", "true"),
+					resource.TestCheckResourceAttr(rootRef, "results.0.failed_controls.0", "required_labels"),
+					resource.TestCheckResourceAttr(rootRef, "failed_images.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAssurancePolicySimulation(image *client.Image) string {
+	return getRegistry(image.Registry) + fmt.Sprintf(`
+	resource "khulnasoft_image" "test" {
+		registry = khulnasoft_integration_registry.demo.id
+		repository = "%s"
+		tag = "%s"
+	}
+
+	data "khulnasoft_assurance_policy_simulation" "test" {
+		name                    = "simulation"
+		application_scopes      = ["Global"]
+		block_failed            = true
+		required_labels_enabled = true
+		required_labels {
+			key = "terraform-test-required-label"
+		}
+
+		image {
+			registry   = khulnasoft_image.test.registry
+			repository = khulnasoft_image.test.repository
+			tag        = khulnasoft_image.test.tag
+		}
+	}
+`, image.Repository, image.Tag)
+}
@@ -0,0 +1,425 @@
+package khulnasoft
+
+import (
+	"context"
+	"crypto/sha1"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataEffectiveRuntimePolicy() *schema.Resource {
+	return &schema.Resource{
+		Description: "The data source `khulnasoft_effective_runtime_policy` resolves the runtime policies applying to a workload described by its attributes. " +
+			"The application scopes, scope expression and bypass scope of every runtime policy are evaluated by the provider against the workload, " +
+			"and the matching policies are returned in evaluation order together with the controls they enable.",
+		ReadContext: dataEffectiveRuntimePolicyRead,
+		Schema: map[string]*schema.Schema{
+			"runtime_type": {
+				Type:         schema.TypeString,
+				Description:  "Only evaluate the runtime policies of this runtime type, one of `container`, `host`, `function` or `kubernetes`. All the runtime policies are evaluated by default.",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(runtimePolicyRuntimeTypes, false),
+			},
+			"image": {
+				Type:        schema.TypeString,
+				Description: "Image of the workload as `repository:tag`, matched by the `image.name` scope attribute, its repository being matched by `image.repo`.",
+				Optional:    true,
+			},
+			"registry": {
+				Type:        schema.TypeString,
+				Description: "Registry of the workload image, matched by the `image.registry` and `khulnasoft.registry` scope attributes.",
+				Optional:    true,
+			},
+			"namespace": {
+				Type:        schema.TypeString,
+				Description: "Kubernetes namespace of the workload, matched by the `kubernetes.namespace` scope attribute.",
+				Optional:    true,
+			},
+			"cluster": {
+				Type:        schema.TypeString,
+				Description: "Kubernetes cluster of the workload, matched by the `kubernetes.cluster` scope attribute.",
+				Optional:    true,
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Description: "Host name of the workload, matched by the `os.hostname` scope attribute.",
+				Optional:    true,
+			},
+			"labels": {
+				Type:        schema.TypeMap,
+				Description: "Labels of the workload, matched by the scope attributes ending with `.label`, e.g. `kubernetes.label` and `image.label`.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"attributes": {
+				Type:        schema.TypeMap,
+				Description: "Other attributes of the workload by scope attribute, e.g. `function.name` or `cloud.awsaccount`. They take precedence over the attributes above.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"include_disabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether disabled runtime policies are evaluated too.",
+				Optional:    true,
+				Default:     false,
+			},
+			"policies": {
+				Type:        schema.TypeList,
+				Description: "Runtime policies applying to the workload, in the order the console evaluates them.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Name of the runtime policy.",
+							Computed:    true,
+						},
+						"runtime_type": {
+							Type:        schema.TypeString,
+							Description: "Runtime type of the runtime policy.",
+							Computed:    true,
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Description: "Whether the runtime policy is enabled.",
+							Computed:    true,
+						},
+						"enforce": {
+							Type:        schema.TypeBool,
+							Description: "Whether the runtime policy is enforced, otherwise it is audit only.",
+							Computed:    true,
+						},
+						"application_scope": {
+							Type:        schema.TypeString,
+							Description: "Application scope of the runtime policy matching the workload.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"policy_names": {
+				Type:        schema.TypeList,
+				Description: "Names of the runtime policies applying to the workload, in the order the console evaluates them.",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"enforce": {
+				Type:        schema.TypeBool,
+				Description: "Whether at least one of the runtime policies applying to the workload is enforced.",
+				Computed:    true,
+			},
+			"controls": {
+				Type:        schema.TypeList,
+				Description: "Controls enabled by at least one of the runtime policies applying to the workload, by name.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Name of the control, as configured on the runtime policy resources, e.g. `drift_prevention`.",
+							Computed:    true,
+						},
+						"policies": {
+							Type:        schema.TypeList,
+							Description: "Names of the runtime policies enabling the control, in evaluation order.",
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"enforced": {
+							Type:        schema.TypeBool,
+							Description: "Whether one of the runtime policies enabling the control is enforced.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataEffectiveRuntimePolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+	runtimeType := d.Get("runtime_type").(string)
+	includeDisabled := d.Get("include_disabled").(bool)
+	workload := runtimePolicyWorkload(d)
+
+	policies, err := c.GetRuntimePolicies()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resolver := &applicationScopeResolver{client: c, scopes: make(map[string]*client.ApplicationScope)}
+	var matched []effectiveRuntimePolicy
+	for i := range policies {
+		crp := &policies[i]
+		if runtimeType != "" && crp.RuntimeType != runtimeType || !crp.Enabled && !includeDisabled {
+			continue
+		}
+		applicationScope, err := matchRuntimePolicy(crp, workload, resolver)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed evaluating runtime policy %s: %v", crp.Name, err))
+		}
+		if applicationScope != "" {
+			matched = append(matched, effectiveRuntimePolicy{policy: crp, applicationScope: applicationScope})
+		}
+	}
+
+	sortEffectiveRuntimePolicies(c, matched)
+
+	var result []map[string]interface{}
+	var names []string
+	enforce := false
+	for _, p := range matched {
+		result = append(result, map[string]interface{}{
+			"name":              p.policy.Name,
+			"runtime_type":      p.policy.RuntimeType,
+			"enabled":           p.policy.Enabled,
+			"enforce":           p.policy.Enforce,
+			"application_scope": p.applicationScope,
+		})
+		names = append(names, p.policy.Name)
+		enforce = enforce || p.policy.Enforce
+	}
+
+	controls, err := effectiveRuntimePolicyControls(matched)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("policies", result)
+	d.Set("policy_names", names)
+	d.Set("enforce", enforce)
+	d.Set("controls", controls)
+	d.SetId(fmt.Sprintf("%x", sha1.Sum([]byte(runtimeType+"|"+strings.Join(names, ",")+"|"+fmt.Sprint(workload.attributes, workload.labels)))))
+
+	return nil
+}
+
+type effectiveRuntimePolicy struct {
+	policy           *client.RuntimePolicy
+	applicationScope string
+}
+
+// runtimePolicyWorkload builds the workload the runtime policies are evaluated against from the data source arguments
+func runtimePolicyWorkload(d *schema.ResourceData) scopeWorkload {
+	attributes := make(map[string]string)
+	if image := d.Get("image").(string); image != "" {
+		attributes["image.name"] = image
+		attributes["image.repo"] = imageRepository(image)
+	}
+	if registry := d.Get("registry").(string); registry != "" {
+		attributes["image.registry"] = registry
+		attributes["khulnasoft.registry"] = registry
+	}
+	if namespace := d.Get("namespace").(string); namespace != "" {
+		attributes["kubernetes.namespace"] = namespace
+	}
+	if cluster := d.Get("cluster").(string); cluster != "" {
+		attributes["kubernetes.cluster"] = cluster
+	}
+	if host := d.Get("host").(string); host != "" {
+		attributes["os.hostname"] = host
+	}
+	for k, v := range d.Get("attributes").(map[string]interface{}) {
+		attributes[k] = v.(string)
+	}
+
+	labels := make(map[string]string)
+	for k, v := range d.Get("labels").(map[string]interface{}) {
+		labels[k] = v.(string)
+	}
+	return scopeWorkload{attributes: attributes, labels: labels}
+}
+
+// imageRepository strips the tag or digest of an image
+func imageRepository(image string) string {
+	if i := strings.Index(image, "@"); i >= 0 {
+		image = image[:i]
+	}
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		image = image[:i]
+	}
+	return image
+}
+
+// matchRuntimePolicy returns the application scope through which the runtime policy applies to the workload, or an
+// empty string when it does not apply: the workload must match one of its application scopes and none of its
+// excluded application scopes, its scope, and must not match its bypass scope
+func matchRuntimePolicy(crp *client.RuntimePolicy, w scopeWorkload, resolver *applicationScopeResolver) (string, error) {
+	match, err := matchScope(crp.Scope.Expression, scopeVariablesFromScope(crp.Scope.Variables), w)
+	if err != nil || !match {
+		return "", err
+	}
+
+	if crp.BypassScope.Enabled {
+		bypassed, err := matchScope(crp.BypassScope.Scope.Expression, scopeVariablesFromScope(crp.BypassScope.Scope.Variables), w)
+		if err != nil || bypassed {
+			return "", err
+		}
+	}
+
+	for _, name := range crp.ExcludeApplicationScopes {
+		excluded, err := resolver.match(name, w)
+		if err != nil || excluded {
+			return "", err
+		}
+	}
+
+	for _, name := range crp.ApplicationScopes {
+		match, err := resolver.match(name, w)
+		if err != nil {
+			return "", err
+		}
+		if match {
+			return name, nil
+		}
+	}
+	return "", nil
+}
+
+// applicationScopeResolver evaluates application scopes against a workload, reading each application scope once
+type applicationScopeResolver struct {
+	client *client.Client
+	scopes map[string]*client.ApplicationScope
+}
+
+// match reports whether one of the categories of the application scope matches the workload, the Global application
+// scope matching every workload
+func (r *applicationScopeResolver) match(name string, w scopeWorkload) (bool, error) {
+	if name == "Global" {
+		return true, nil
+	}
+
+	scope, ok := r.scopes[name]
+	if !ok {
+		var err error
+		scope, err = r.client.GetApplicationScope(name)
+		if err != nil {
+			return false, err
+		}
+		r.scopes[name] = scope
+	}
+
+	categories := scope.Categories
+	for _, category := range []client.CommonStruct{
+		categories.Artifacts.Image,
+		categories.Artifacts.Function,
+		categories.Artifacts.CF,
+		categories.Artifacts.CodeBuild,
+		categories.Workloads.Kubernetes,
+		categories.Workloads.OS,
+		categories.Workloads.WCF,
+		categories.Infrastructure.IKubernetes,
+		categories.Infrastructure.IOS,
+	} {
+		// an empty category does not select anything
+		if category.Expression == "" && len(category.Variables) == 0 {
+			continue
+		}
+		match, err := matchScope(category.Expression, scopeVariablesFromApplicationScope(category.Variables), w)
+		if err != nil {
+			return false, fmt.Errorf("application scope %s: %v", name, err)
+		}
+		if match {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// sortEffectiveRuntimePolicies sorts the runtime policies by runtime type, then in the order the console evaluates
+// them. Policies whose order cannot be read are sorted by name.
+func sortEffectiveRuntimePolicies(c *client.Client, policies []effectiveRuntimePolicy) {
+	positions := make(map[string]int)
+	orders := make(map[string]bool)
+	for _, p := range policies {
+		runtimeType := p.policy.RuntimeType
+		if orders[runtimeType] {
+			continue
+		}
+		orders[runtimeType] = true
+		order, err := c.GetRuntimePolicyOrder(runtimeType)
+		if err != nil {
+			log.Printf("[WARN] Failed reading the order of the %s runtime policies, sorting them by name: %v", runtimeType, err)
+			continue
+		}
+		for i, name := range order.Policies {
+			positions[runtimeType+"/"+name] = i + 1
+		}
+	}
+
+	sort.SliceStable(policies, func(i, j int) bool {
+		a, b := policies[i].policy, policies[j].policy
+		if a.RuntimeType != b.RuntimeType {
+			return a.RuntimeType < b.RuntimeType
+		}
+		pa, pb := positions[a.RuntimeType+"/"+a.Name], positions[b.RuntimeType+"/"+b.Name]
+		if pa != pb && pa != 0 && pb != 0 {
+			return pa < pb
+		}
+		if (pa == 0) != (pb == 0) {
+			return pb == 0
+		}
+		return a.Name < b.Name
+	})
+}
+
+// effectiveRuntimePolicyControls returns the controls enabled by the runtime policies, a boolean control being
+// enabled when set and a block control when its enabled attribute is set
+func effectiveRuntimePolicyControls(policies []effectiveRuntimePolicy) ([]map[string]interface{}, error) {
+	var names []string
+	for name := range runtimePolicyControls {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var controls []map[string]interface{}
+	enabledBy := make(map[string][]string)
+	enforced := make(map[string]bool)
+	for _, p := range policies {
+		fields, err := policyFields(p.policy)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range names {
+			enabled := false
+			switch v := fields[name].(type) {
+			case bool:
+				enabled = v
+			case map[string]interface{}:
+				enabled, _ = v["enabled"].(bool)
+			}
+			if enabled {
+				enabledBy[name] = append(enabledBy[name], p.policy.Name)
+				enforced[name] = enforced[name] || p.policy.Enforce
+			}
+		}
+	}
+
+	for _, name := range names {
+		if len(enabledBy[name]) == 0 {
+			continue
+		}
+		controls = append(controls, map[string]interface{}{
+			"name":     name,
+			"policies": enabledBy[name],
+			"enforced": enforced[name],
+		})
+	}
+	return controls, nil
+}
@@ -0,0 +1,108 @@
+package khulnasoft
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestImageRepository(t *testing.T) {
+	cases := map[string]string{
+		"nginx":                 "nginx",
+		"library/nginx:1.25":    "library/nginx",
+		"registry:5000/app":     "registry:5000/app",
+		"registry:5000/app:1.0": "registry:5000/app",
+		"app@sha256:abc":        "app",
+		"app:1.0@sha256:abc":    "app",
+	}
+	for image, expected := range cases {
+		if got := imageRepository(image); got != expected {
+			t.Errorf("%q: expected %q, got %q", image, expected, got)
+		}
+	}
+}
+
+func TestDataKhulnasoftEffectiveRuntimePolicy(t *testing.T) {
+	t.Parallel()
+	name := acctest.RandomWithPrefix("test-effective-runtime-policy")
+	rootRef := "data.khulnasoft_effective_runtime_policy.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEffectiveRuntimePolicy(name, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr(rootRef, "policy_names.*", name),
+					resource.TestCheckTypeSetElemNestedAttrs(rootRef, "policies.*", map[string]string{
+						"name":              name,
+						"runtime_type":      "container",
+						"application_scope": "Global",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(rootRef, "controls.*", map[string]string{
+						"name":     "drift_prevention",
+						"enforced": "false",
+					}),
+				),
+			},
+			{
+				// the other namespace does not match the scope of the policy
+				Config: testAccEffectiveRuntimePolicy(name, "search"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNoElement(rootRef, "policy_names", name),
+				),
+			},
+		},
+	})
+}
+
+func testAccEffectiveRuntimePolicy(name, namespace string) string {
+	return fmt.Sprintf(`
+	resource "khulnasoft_container_runtime_policy" "test" {
+		name = "%s"
+		description = "terraform-test"
+		enabled = true
+		scope_expression = "v1 && v2"
+		scope_variables {
+			attribute = "kubernetes.namespace"
+			value     = "%s"
+		}
+		scope_variables {
+			attribute = "kubernetes.label"
+			name      = "app"
+			value     = "checkout*"
+		}
+		drift_prevention {
+			enabled = true
+		}
+	}
+
+	data "khulnasoft_effective_runtime_policy" "test" {
+		runtime_type = "container"
+		namespace    = "%s"
+		labels = {
+			app = "checkout-api"
+		}
+		depends_on = [khulnasoft_container_runtime_policy.test]
+	}
+`, name, name, namespace)
+}
+
+func testAccCheckNoElement(name, attribute, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+		for k, v := range rs.Primary.Attributes {
+			if strings.HasPrefix(k, attribute+".") && k != attribute+".#" && v == value {
+				return fmt.Errorf("%s: %s contains %q", name, attribute, value)
+			}
+		}
+		return nil
+	}
+}
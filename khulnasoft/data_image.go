@@ -338,6 +338,64 @@ func dataImage() *schema.Resource {
 					},
 				},
 			},
+			"assurance_failures": {
+				Type:        schema.TypeList,
+				Description: "The failed image assurance checks, grouped by the Assurance Policy they originated from.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"policy_name": {
+							Type:        schema.TypeString,
+							Description: "The name of the Assurance Policy the failed checks originated from.",
+							Computed:    true,
+						},
+						"assurance_type": {
+							Type:        schema.TypeString,
+							Description: "The type of the Assurance Policy the failed checks originated from.",
+							Computed:    true,
+						},
+						"blocking": {
+							Type:        schema.TypeBool,
+							Description: "Whether at least one of the failed checks of this policy is blocking.",
+							Computed:    true,
+						},
+						"failed_controls": {
+							Type:        schema.TypeList,
+							Description: "The controls of this policy that the image failed.",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"control": {
+										Type:        schema.TypeString,
+										Description: "The name of the image assurance control.",
+										Computed:    true,
+									},
+									"blocking": {
+										Type:        schema.TypeBool,
+										Description: "Whether the control is blocking (i.e. a failure should trigger a disallow).",
+										Computed:    true,
+									},
+									"dta_skipped": {
+										Type:        schema.TypeBool,
+										Description: "If DTA was skipped.",
+										Computed:    true,
+									},
+									"dta_skipped_reason": {
+										Type:        schema.TypeString,
+										Description: "The reason why DTA was skipped.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"assurance_explanation": {
+				Type:        schema.TypeString,
+				Description: "A human-readable explanation of why the image is allowed or disallowed by the Image Assurance Policies.",
+				Computed:    true,
+			},
 			"vulnerabilities": {
 				Type:        schema.TypeList,
 				Description: "A list of all the vulnerabilities found in the image",
@@ -727,6 +785,8 @@ func dataImageRead(ctx context.Context, d *schema.ResourceData, m interface{}) d
 		d.Set("dta_skipped_reason", newImage.DtaSkippedReason)
 		d.Set("disallowed_by_assurance_checks", newImage.AssuranceResults.Disallowed)
 		d.Set("assurance_checks_performed", flattenAssuranceChecksPerformed(newImage.AssuranceResults.ChecksPerformed))
+		d.Set("assurance_failures", flattenAssuranceFailures(newImage.AssuranceResults.ChecksPerformed))
+		d.Set("assurance_explanation", explainAssuranceResults(newImage))
 		d.Set("history", flattenHistory(newImage.History))
 		d.Set("vulnerabilities", flattenVulnerabilities(vulnerabilities))
 
@@ -44,6 +44,7 @@ func TestDataSourceKhulnasoftImage(t *testing.T) {
 					resource.TestCheckResourceAttrSet(rootRef, "total_vulnerabilities"),
 					resource.TestCheckResourceAttr(rootRef, "author", os.Getenv("KHULNASOFT_USER")),
 					resource.TestCheckResourceAttrSet(rootRef, "image_size"),
+					resource.TestCheckResourceAttrSet(rootRef, "assurance_explanation"),
 				),
 			},
 		},
@@ -0,0 +1,391 @@
+package khulnasoft
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/rand"
+	"path"
+	"time"
+
+	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceImages() *schema.Resource {
+	return &schema.Resource{
+		Description: "The data source `khulnasoft_images` provides a method to query the registered images inventory within the Khulnasoft. " +
+			"The images can be narrowed down with the filter arguments below, and the result can be paginated.",
+		ReadContext: dataImagesRead,
+		Schema: map[string]*schema.Schema{
+			"registry": {
+				Type:        schema.TypeString,
+				Description: "Only return images stored in this registry.",
+				Optional:    true,
+			},
+			"repository": {
+				Type:        schema.TypeString,
+				Description: "Only return images whose repository matches this pattern. Shell-style wildcards (`*`, `?`, `[...]`) are supported; `*` does not match `/`.",
+				Optional:    true,
+				ValidateFunc: func(v interface{}, k string) ([]string, []error) {
+					if _, err := path.Match(v.(string), ""); err != nil {
+						return nil, []error{fmt.Errorf("%q is not a valid pattern: %v", k, err)}
+					}
+					return nil, nil
+				},
+			},
+			"scan_status": {
+				Type:         schema.TypeString,
+				Description:  "Only return images with this scan status (either 'pending', 'in_progress', 'finished', 'failed' or 'not_started').",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"pending", "in_progress", "finished", "failed", "not_started"}, false),
+			},
+			"disallowed": {
+				Type:        schema.TypeBool,
+				Description: "If set, only return images whose disallowed (non-compliant) flag equals this value.",
+				Optional:    true,
+			},
+			"labels": {
+				Type:        schema.TypeList,
+				Description: "Only return images that carry all of these Khulnasoft labels.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"newer_image_exists": {
+				Type:        schema.TypeBool,
+				Description: "If set, only return images whose newer_image_exists flag equals this value.",
+				Optional:    true,
+			},
+			"min_critical_vulnerabilities": {
+				Type:         schema.TypeInt,
+				Description:  "Only return images with at least this number of critical severity vulnerabilities.",
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"scanned_after": {
+				Type:         schema.TypeString,
+				Description:  "Only return images last scanned at or after this RFC3339 timestamp.",
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"scanned_before": {
+				Type:         schema.TypeString,
+				Description:  "Only return images last scanned at or before this RFC3339 timestamp.",
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"page": {
+				Type:         schema.TypeInt,
+				Description:  "The page of matching images to return, starting from 1. When omitted, all the matching images are returned.",
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"page_size": {
+				Type:         schema.TypeInt,
+				Description:  "The number of images per page. Only used together with `page`.",
+				Optional:     true,
+				Default:      50,
+				ValidateFunc: validation.IntBetween(1, 1000),
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Description: "The total number of images matching the filters, regardless of pagination.",
+				Computed:    true,
+			},
+			"images": {
+				Type:        schema.TypeList,
+				Description: "A list of the matching images.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Description: "The ID of the image, in the same `registry/repository:tag` format as `khulnasoft_image`.",
+							Computed:    true,
+						},
+						"registry": {
+							Type:        schema.TypeString,
+							Description: "The name of the registry where the image is stored.",
+							Computed:    true,
+						},
+						"repository": {
+							Type:        schema.TypeString,
+							Description: "The name of the image's repository.",
+							Computed:    true,
+						},
+						"tag": {
+							Type:        schema.TypeString,
+							Description: "The tag of the image.",
+							Computed:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the image.",
+							Computed:    true,
+						},
+						"digest": {
+							Type:        schema.TypeString,
+							Description: "The content digest of the image.",
+							Computed:    true,
+						},
+						"labels": {
+							Type:        schema.TypeList,
+							Description: "Khulnasoft labels of the image.",
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"os": {
+							Type:        schema.TypeString,
+							Description: "The operating system detected in the image",
+							Computed:    true,
+						},
+						"os_version": {
+							Type:        schema.TypeString,
+							Description: "The version of the OS detected in the image.",
+							Computed:    true,
+						},
+						"scan_status": {
+							Type:        schema.TypeString,
+							Description: "The scan status of the image (either 'pending', 'in_progress', 'finished', 'failed' or 'not_started').",
+							Computed:    true,
+						},
+						"scan_date": {
+							Type:        schema.TypeString,
+							Description: "The date and time when the image was last scanned.",
+							Computed:    true,
+						},
+						"disallowed": {
+							Type:        schema.TypeBool,
+							Description: "Whether the image is disallowed (non-compliant).",
+							Computed:    true,
+						},
+						"permission": {
+							Type:        schema.TypeString,
+							Description: "Permission of the image.",
+							Computed:    true,
+						},
+						"newer_image_exists": {
+							Type:        schema.TypeBool,
+							Description: "Whether a new version of the image is available in the registry but is not scanned and registered yet.",
+							Computed:    true,
+						},
+						"critical_vulnerabilities": {
+							Type:        schema.TypeInt,
+							Description: "Number of critical severity vulnerabilities detected in the image.",
+							Computed:    true,
+						},
+						"high_vulnerabilities": {
+							Type:        schema.TypeInt,
+							Description: "Number of high severity vulnerabilities detected in the image.",
+							Computed:    true,
+						},
+						"medium_vulnerabilities": {
+							Type:        schema.TypeInt,
+							Description: "Number of medium severity vulnerabilities detected in the image.",
+							Computed:    true,
+						},
+						"low_vulnerabilities": {
+							Type:        schema.TypeInt,
+							Description: "Number of low severity vulnerabilities detected in the image.",
+							Computed:    true,
+						},
+						"negligible_vulnerabilities": {
+							Type:        schema.TypeInt,
+							Description: "Number of negligible severity vulnerabilities detected in the image.",
+							Computed:    true,
+						},
+						"total_vulnerabilities": {
+							Type:        schema.TypeInt,
+							Description: "The total number of vulnerabilities detected in the image.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// imagesFilter holds the client side filters of the khulnasoft_images data source.
+// Pointer fields are only applied when set in the configuration.
+type imagesFilter struct {
+	repository       string
+	scanStatus       string
+	disallowed       *bool
+	labels           []string
+	newerImageExists *bool
+	minCritVulns     int
+	scannedAfter     *time.Time
+	scannedBefore    *time.Time
+}
+
+func dataImagesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG]  inside dataImagesRead")
+	c := m.(*client.Client)
+
+	filter, err := expandImagesFilter(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	images, err := c.GetImages(d.Get("registry").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	matched := make([]client.Image, 0, len(images))
+	for _, image := range images {
+		if filter.matches(&image) {
+			matched = append(matched, image)
+		}
+	}
+
+	paged := matched
+	if page, ok := d.GetOk("page"); ok {
+		pageSize := d.Get("page_size").(int)
+		start := (page.(int) - 1) * pageSize
+		end := start + pageSize
+		if start > len(matched) {
+			start = len(matched)
+		}
+		if end > len(matched) {
+			end = len(matched)
+		}
+		paged = matched[start:end]
+	}
+
+	result, id := flattenImagesData(paged)
+	if id == "" {
+		id = fmt.Sprintf("no-image-found-%d", rand.Int())
+	}
+	d.SetId(id)
+	if err := d.Set("images", result); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("total_count", len(matched))
+
+	return nil
+}
+
+func expandImagesFilter(d *schema.ResourceData) (*imagesFilter, error) {
+	filter := imagesFilter{
+		repository:   d.Get("repository").(string),
+		scanStatus:   d.Get("scan_status").(string),
+		labels:       convertStringArr(d.Get("labels").([]interface{})),
+		minCritVulns: d.Get("min_critical_vulnerabilities").(int),
+	}
+
+	rawConfig := d.GetRawConfig()
+	if v := rawConfig.GetAttr("disallowed"); !v.IsNull() {
+		disallowed := v.True()
+		filter.disallowed = &disallowed
+	}
+	if v := rawConfig.GetAttr("newer_image_exists"); !v.IsNull() {
+		newerImageExists := v.True()
+		filter.newerImageExists = &newerImageExists
+	}
+
+	if v, ok := d.GetOk("scanned_after"); ok {
+		scannedAfter, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return nil, err
+		}
+		filter.scannedAfter = &scannedAfter
+	}
+	if v, ok := d.GetOk("scanned_before"); ok {
+		scannedBefore, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return nil, err
+		}
+		filter.scannedBefore = &scannedBefore
+	}
+
+	return &filter, nil
+}
+
+func (f *imagesFilter) matches(image *client.Image) bool {
+	if f.repository != "" {
+		if ok, _ := path.Match(f.repository, image.Repository); !ok {
+			return false
+		}
+	}
+	if f.scanStatus != "" && f.scanStatus != image.ScanStatus {
+		return false
+	}
+	if f.disallowed != nil && *f.disallowed != image.Disallowed {
+		return false
+	}
+	if f.newerImageExists != nil && *f.newerImageExists != image.NewerImageExists {
+		return false
+	}
+	if image.CritVulns < f.minCritVulns {
+		return false
+	}
+	for _, label := range f.labels {
+		found := false
+		for _, imageLabel := range image.Labels {
+			if imageLabel == label {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if f.scannedAfter != nil || f.scannedBefore != nil {
+		scanDate, err := time.Parse(time.RFC3339, image.ScanDate)
+		if err != nil {
+			// images that were never scanned have no scan date to compare
+			return false
+		}
+		if f.scannedAfter != nil && scanDate.Before(*f.scannedAfter) {
+			return false
+		}
+		if f.scannedBefore != nil && scanDate.After(*f.scannedBefore) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func flattenImagesData(images []client.Image) ([]interface{}, string) {
+	id := ""
+	imgs := make([]interface{}, len(images), len(images))
+
+	for i, image := range images {
+		imageId := getImageId(&image)
+		id = id + imageId
+		img := make(map[string]interface{})
+		img["id"] = imageId
+		img["registry"] = image.Registry
+		img["repository"] = image.Repository
+		img["tag"] = image.Tag
+		img["name"] = image.Name
+		img["digest"] = image.Digest
+		img["labels"] = image.Labels
+		img["os"] = image.Os
+		img["os_version"] = image.OsVersion
+		img["scan_status"] = image.ScanStatus
+		img["scan_date"] = image.ScanDate
+		img["disallowed"] = image.Disallowed
+		img["permission"] = image.Permission
+		img["newer_image_exists"] = image.NewerImageExists
+		img["critical_vulnerabilities"] = image.CritVulns
+		img["high_vulnerabilities"] = image.HighVulns
+		img["medium_vulnerabilities"] = image.MedVulns
+		img["low_vulnerabilities"] = image.LowVulns
+		img["negligible_vulnerabilities"] = image.NegVulns
+		img["total_vulnerabilities"] = image.VulnsFound
+		imgs[i] = img
+	}
+
+	return imgs, id
+}
@@ -0,0 +1,57 @@
+package khulnasoft
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestDataSourceKhulnasoftImages(t *testing.T) {
+	t.Parallel()
+	image := client.Image{
+		Registry:   acctest.RandomWithPrefix("terraform-test"),
+		Repository: "alpine",
+		Tag:        "3.13",
+	}
+	rootRef := "data.khulnasoft_images.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: getImagesDataSource(&image),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rootRef, "total_count", "1"),
+					resource.TestCheckResourceAttr(rootRef, "images.#", "1"),
+					resource.TestCheckResourceAttr(rootRef, "images.0.registry", image.Registry),
+					resource.TestCheckResourceAttr(rootRef, "images.0.repository", image.Repository),
+					resource.TestCheckResourceAttr(rootRef, "images.0.tag", image.Tag),
+					resource.TestCheckResourceAttrSet(rootRef, "images.0.scan_status"),
+					resource.TestCheckResourceAttrSet(rootRef, "images.0.critical_vulnerabilities"),
+				),
+			},
+		},
+	})
+}
+
+func getImagesDataSource(image *client.Image) string {
+	return getRegistry(image.Registry) + fmt.Sprintf(`
+	resource "khulnasoft_image" "test" {
+		registry = khulnasoft_integration_registry.demo.id
+		repository = "%s"
+		tag = "%s"
+	}
+
+	data "khulnasoft_images" "test" {
+		registry   = khulnasoft_image.test.registry
+		repository = "alp*"
+		page       = 1
+		page_size  = 10
+	}
+`, image.Repository, image.Tag)
+}
@@ -0,0 +1,196 @@
+package khulnasoft
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/rand"
+	"strings"
+
+	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceKubernetesControls() *schema.Resource {
+	return &schema.Resource{
+		Description: "The data source `khulnasoft_kubernetes_controls` provides a method to query the Kubernetes controls that can be used in the `kubernetes_controls` blocks of the Kubernetes assurance policies. " +
+			"The controls can be narrowed down with the filter arguments below.",
+		ReadContext: dataKubernetesControlsRead,
+		Schema: map[string]*schema.Schema{
+			"severities": {
+				Type:        schema.TypeList,
+				Description: "Only return controls with one of these severities (any of 'critical', 'high', 'medium' or 'low').",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"critical", "high", "medium", "low"}, true),
+				},
+			},
+			"kinds": {
+				Type:        schema.TypeList,
+				Description: "Only return controls applying to one of these Kubernetes resource kinds, e.g. `Pod` or `Deployment`. The match is case insensitive.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"keyword": {
+				Type:         schema.TypeString,
+				Description:  "Only return controls whose name, description or AVD ID contains this keyword. The match is case insensitive.",
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"ootb": {
+				Type:        schema.TypeBool,
+				Description: "If set, only return controls whose out-of-the-box flag equals this value.",
+				Optional:    true,
+			},
+			"controls": {
+				Type:        schema.TypeList,
+				Description: "A list of the matching controls, in the same shape as the `kubernetes_controls` block of `khulnasoft_kubernetes_assurance_policy`.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"script_id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the control script.",
+							Computed:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the control.",
+							Computed:    true,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Description: "The description of the control.",
+							Computed:    true,
+						},
+						"severity": {
+							Type:        schema.TypeString,
+							Description: "The severity of the control.",
+							Computed:    true,
+						},
+						"kind": {
+							Type:        schema.TypeString,
+							Description: "The Kubernetes resource kind the control applies to.",
+							Computed:    true,
+						},
+						"ootb": {
+							Type:        schema.TypeBool,
+							Description: "Whether the control is provided out of the box.",
+							Computed:    true,
+						},
+						"avd_id": {
+							Type:        schema.TypeString,
+							Description: "The Khulnasoft Vulnerability Database ID of the control.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"avd_ids": {
+				Type:        schema.TypeList,
+				Description: "The AVD IDs of the matching controls.",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+// kubernetesControlsFilter holds the client side filters of the khulnasoft_kubernetes_controls data source.
+type kubernetesControlsFilter struct {
+	severities []string
+	kinds      []string
+	keyword    string
+	ootb       *bool
+}
+
+func dataKubernetesControlsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG]  inside dataKubernetesControlsRead")
+	c := m.(*client.Client)
+
+	filter := kubernetesControlsFilter{
+		severities: convertStringArr(d.Get("severities").([]interface{})),
+		kinds:      convertStringArr(d.Get("kinds").([]interface{})),
+		keyword:    d.Get("keyword").(string),
+	}
+	if v := d.GetRawConfig().GetAttr("ootb"); !v.IsNull() {
+		ootb := v.True()
+		filter.ootb = &ootb
+	}
+
+	controls, err := c.GetKubernetesControls()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := ""
+	result := make([]interface{}, 0, len(controls))
+	avdIds := make([]string, 0, len(controls))
+	for _, control := range controls {
+		if !filter.matches(&control) {
+			continue
+		}
+		id = id + fmt.Sprint(control.ScriptID)
+		result = append(result, map[string]interface{}{
+			"script_id":   control.ScriptID,
+			"name":        control.Name,
+			"description": control.Description,
+			"severity":    control.Severity,
+			"kind":        control.Kind,
+			"ootb":        control.OOTB,
+			"avd_id":      control.AvdID,
+		})
+		if control.AvdID != "" {
+			avdIds = append(avdIds, control.AvdID)
+		}
+	}
+
+	if id == "" {
+		id = fmt.Sprintf("no-kubernetes-control-found-%d", rand.Int())
+	}
+	d.SetId(id)
+	if err := d.Set("controls", result); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("avd_ids", avdIds)
+
+	return nil
+}
+
+func (f *kubernetesControlsFilter) matches(control *client.KubernetesControls) bool {
+	if len(f.severities) > 0 && !containsFold(f.severities, control.Severity) {
+		return false
+	}
+	if len(f.kinds) > 0 && !containsFold(f.kinds, control.Kind) {
+		return false
+	}
+	if f.ootb != nil && *f.ootb != control.OOTB {
+		return false
+	}
+	if f.keyword != "" {
+		keyword := strings.ToLower(f.keyword)
+		if !strings.Contains(strings.ToLower(control.Name), keyword) &&
+			!strings.Contains(strings.ToLower(control.Description), keyword) &&
+			!strings.Contains(strings.ToLower(control.AvdID), keyword) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func containsFold(entries []string, entry string) bool {
+	for _, e := range entries {
+		if strings.EqualFold(e, entry) {
+			return true
+		}
+	}
+	return false
+}
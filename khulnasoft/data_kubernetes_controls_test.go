@@ -0,0 +1,73 @@
+package khulnasoft
+
+import (
+	"testing"
+
+	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestKubernetesControlsFilter(t *testing.T) {
+	controls := []client.KubernetesControls{
+		{ScriptID: 1, Name: "Privileged container", Severity: "critical", Kind: "Pod", OOTB: true, AvdID: "AVD-KSV-0017"},
+		{ScriptID: 2, Name: "Runs as root", Description: "Container runs as the root user", Severity: "high", Kind: "Pod", OOTB: true, AvdID: "AVD-KSV-0012"},
+		{ScriptID: 3, Name: "Host network", Severity: "critical", Kind: "Deployment", AvdID: "AVD-KSV-0009"},
+	}
+	yes := true
+
+	cases := []struct {
+		name      string
+		filter    kubernetesControlsFilter
+		scriptIds []int
+	}{
+		{name: "no filter", scriptIds: []int{1, 2, 3}},
+		{name: "severity", filter: kubernetesControlsFilter{severities: []string{"CRITICAL"}}, scriptIds: []int{1, 3}},
+		{name: "severity and kind", filter: kubernetesControlsFilter{severities: []string{"critical"}, kinds: []string{"pod"}}, scriptIds: []int{1}},
+		{name: "keyword in description", filter: kubernetesControlsFilter{keyword: "ROOT USER"}, scriptIds: []int{2}},
+		{name: "keyword in avd id", filter: kubernetesControlsFilter{keyword: "ksv-0009"}, scriptIds: []int{3}},
+		{name: "ootb", filter: kubernetesControlsFilter{ootb: &yes}, scriptIds: []int{1, 2}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var scriptIds []int
+			for _, control := range controls {
+				if tc.filter.matches(&control) {
+					scriptIds = append(scriptIds, control.ScriptID)
+				}
+			}
+			if len(scriptIds) != len(tc.scriptIds) {
+				t.Fatalf("expected script IDs %v, got %v", tc.scriptIds, scriptIds)
+			}
+			for i := range scriptIds {
+				if scriptIds[i] != tc.scriptIds[i] {
+					t.Fatalf("expected script IDs %v, got %v", tc.scriptIds, scriptIds)
+				}
+			}
+		})
+	}
+}
+
+func TestDataSourceKhulnasoftKubernetesControls(t *testing.T) {
+	t.Parallel()
+	rootRef := "data.khulnasoft_kubernetes_controls.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+	data "khulnasoft_kubernetes_controls" "test" {
+		severities = ["critical"]
+		kinds      = ["Pod"]
+	}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(rootRef, "controls.#"),
+					resource.TestCheckResourceAttr(rootRef, "controls.0.severity", "critical"),
+					resource.TestCheckResourceAttr(rootRef, "controls.0.kind", "Pod"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,111 @@
+package khulnasoft
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/rand"
+	"strings"
+
+	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceLicenses() *schema.Resource {
+	return &schema.Resource{
+		Description: "The data source `khulnasoft_licenses` provides a method to query the licenses found in the packages of the scanned images, " +
+			"with the license category of each license, to review them before adding them to the license lists of the assurance policies.",
+		ReadContext: dataLicensesRead,
+		Schema: map[string]*schema.Schema{
+			"registry": {
+				Type:        schema.TypeString,
+				Description: "Only return licenses found in the images of this registry.",
+				Optional:    true,
+			},
+			"categories": {
+				Type:        schema.TypeList,
+				Description: fmt.Sprintf("Only return licenses of these categories (any of '%s' or 'uncategorized').", strings.Join(licenseCategoryNames(), "', '")),
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(append(licenseCategoryNames(), "uncategorized"), false),
+				},
+			},
+			"licenses": {
+				Type:        schema.TypeList,
+				Description: "A list of the matching licenses.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"license": {
+							Type:        schema.TypeString,
+							Description: "The license, as reported by the scans.",
+							Computed:    true,
+						},
+						"spdx": {
+							Type:        schema.TypeBool,
+							Description: "Whether the license is a valid SPDX license identifier or expression, which can be added as is to the license lists.",
+							Computed:    true,
+						},
+						"category": {
+							Type:        schema.TypeString,
+							Description: "The category of the license, or of all the licenses of a license expression, either 'copyleft', 'permissive', 'proprietary' or 'uncategorized'.",
+							Computed:    true,
+						},
+						"images_count": {
+							Type:        schema.TypeInt,
+							Description: "The number of images with packages under the license.",
+							Computed:    true,
+						},
+						"packages_count": {
+							Type:        schema.TypeInt,
+							Description: "The number of packages under the license.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataLicensesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG]  inside dataLicensesRead")
+	c := m.(*client.Client)
+
+	licenses, err := c.GetLicenses(d.Get("registry").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	categories := convertStringArr(d.Get("categories").([]interface{}))
+	id := ""
+	result := make([]interface{}, 0, len(licenses))
+	for _, license := range licenses {
+		category := scannedLicenseCategory(license.License)
+		if len(categories) > 0 && !containsStringEntry(categories, category) {
+			continue
+		}
+		_, err := parseLicenseExpression(license.License)
+		id = id + license.License
+		result = append(result, map[string]interface{}{
+			"license":        license.License,
+			"spdx":           err == nil,
+			"category":       category,
+			"images_count":   license.ImagesCount,
+			"packages_count": license.PackagesCount,
+		})
+	}
+
+	if id == "" {
+		id = fmt.Sprintf("no-license-found-%d", rand.Int())
+	}
+	d.SetId(id)
+	if err := d.Set("licenses", result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
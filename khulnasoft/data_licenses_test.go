@@ -0,0 +1,29 @@
+package khulnasoft
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestDataSourceKhulnasoftLicenses(t *testing.T) {
+	t.Parallel()
+	rootRef := "data.khulnasoft_licenses.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+	data "khulnasoft_licenses" "test" {
+		categories = ["copyleft"]
+	}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(rootRef, "licenses.#"),
+					resource.TestCheckResourceAttr(rootRef, "licenses.0.category", "copyleft"),
+				),
+			},
+		},
+	})
+}
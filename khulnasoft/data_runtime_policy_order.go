@@ -0,0 +1,62 @@
+package khulnasoft
+
+import (
+	"context"
+
+	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataRuntimePolicyOrder() *schema.Resource {
+	return &schema.Resource{
+		Description: "The data source `khulnasoft_runtime_policy_order` provides the order in which the console evaluates the runtime policies of a runtime type " +
+			"that match the same workload, highest precedence first.",
+		ReadContext: dataRuntimePolicyOrderRead,
+		Schema: map[string]*schema.Schema{
+			"runtime_type": {
+				Type:         schema.TypeString,
+				Description:  "Runtime type of the ordered policies, one of `container`, `host`, `function` or `kubernetes`. Windows host policies are `host` policies.",
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(runtimePolicyRuntimeTypes, false),
+			},
+			"evaluation_order": {
+				Type:        schema.TypeList,
+				Description: "Names of all the runtime policies of the runtime type, in the order the console evaluates them.",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"priorities": {
+				Type:        schema.TypeMap,
+				Description: "Position of each runtime policy in the evaluation order, by policy name, starting from 1 for the policy evaluated first.",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+		},
+	}
+}
+
+func dataRuntimePolicyOrderRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+	runtimeType := d.Get("runtime_type").(string)
+
+	order, err := c.GetRuntimePolicyOrder(runtimeType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	priorities := make(map[string]interface{}, len(order.Policies))
+	for i, name := range order.Policies {
+		priorities[name] = i + 1
+	}
+	d.Set("evaluation_order", order.Policies)
+	d.Set("priorities", priorities)
+	d.SetId(runtimeType)
+
+	return nil
+}
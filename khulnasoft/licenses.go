@@ -0,0 +1,435 @@
+package khulnasoft
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// licenseCategories are the license categories of the license lists, each expanding to the SPDX identifiers of
+// its licenses. Copyleft includes the weak copyleft licenses, proprietary the source available and non-commercial
+// licenses.
+var licenseCategories = map[string][]string{
+	"copyleft": {
+		"AGPL-1.0", "AGPL-1.0-only", "AGPL-1.0-or-later", "AGPL-3.0", "AGPL-3.0-only", "AGPL-3.0-or-later", "APSL-2.0",
+		"CC-BY-SA-3.0", "CC-BY-SA-4.0", "CDDL-1.0", "CDDL-1.1", "CECILL-2.1", "CPL-1.0", "EPL-1.0", "EPL-2.0",
+		"EUPL-1.1", "EUPL-1.2", "GFDL-1.2-only", "GFDL-1.2-or-later", "GFDL-1.3-only", "GFDL-1.3-or-later", "GPL-1.0",
+		"GPL-1.0+", "GPL-1.0-only", "GPL-1.0-or-later", "GPL-2.0", "GPL-2.0+", "GPL-2.0-only", "GPL-2.0-or-later",
+		"GPL-3.0", "GPL-3.0+", "GPL-3.0-only", "GPL-3.0-or-later", "LGPL-2.0", "LGPL-2.0+", "LGPL-2.0-only",
+		"LGPL-2.0-or-later", "LGPL-2.1", "LGPL-2.1+", "LGPL-2.1-only", "LGPL-2.1-or-later", "LGPL-3.0", "LGPL-3.0+",
+		"LGPL-3.0-only", "LGPL-3.0-or-later", "MPL-1.0", "MPL-1.1", "MPL-2.0", "MPL-2.0-no-copyleft-exception",
+		"MS-RL", "ODbL-1.0", "OFL-1.0", "OFL-1.1", "OSL-3.0", "QPL-1.0", "RPL-1.5", "Sleepycat",
+	},
+	"permissive": {
+		"0BSD", "AFL-1.1", "AFL-1.2", "AFL-2.0", "AFL-2.1", "AFL-3.0", "Apache-1.0", "Apache-1.1", "Apache-2.0",
+		"Artistic-2.0", "Beerware", "BlueOak-1.0.0", "BSD-1-Clause", "BSD-2-Clause", "BSD-2-Clause-Patent",
+		"BSD-3-Clause", "BSD-3-Clause-Clear", "BSD-4-Clause", "BSL-1.0", "bzip2-1.0.6", "CC-BY-2.0", "CC-BY-3.0",
+		"CC-BY-4.0", "CC0-1.0", "curl", "ECL-2.0", "EFL-2.0", "FTL", "HPND", "ICU", "IJG", "ISC", "Libpng",
+		"libpng-2.0", "MirOS", "MIT", "MIT-0", "MIT-CMU", "MS-PL", "NCSA", "OpenSSL", "PHP-3.0", "PHP-3.01",
+		"PostgreSQL", "PSF-2.0", "Python-2.0", "Ruby", "Unicode-3.0", "Unicode-DFS-2015", "Unicode-DFS-2016",
+		"Unlicense", "UPL-1.0", "W3C", "WTFPL", "X11", "Zlib", "ZPL-2.0", "ZPL-2.1",
+	},
+	"proprietary": {
+		"BUSL-1.1", "CC-BY-NC-3.0", "CC-BY-NC-4.0", "CC-BY-NC-ND-4.0", "CC-BY-NC-SA-4.0", "CC-BY-ND-4.0",
+		"Elastic-2.0", "PolyForm-Noncommercial-1.0.0", "PolyForm-Small-Business-1.0.0", "SSPL-1.0",
+	},
+}
+
+var spdxLicenses = canonicalIds(spdxLicenseIds)
+
+var spdxLicenseExceptions = canonicalIds(spdxLicenseExceptionIds)
+
+var licenseRefPattern = regexp.MustCompile(`^(DocumentRef-[A-Za-z0-9.-]+:)?LicenseRef-[A-Za-z0-9.-]+$`)
+
+var licenseIdPattern = regexp.MustCompile(`^[A-Za-z0-9.-]+\+?$`)
+
+// canonicalIds maps the lower case identifiers to their canonical case, identifiers being case insensitive
+func canonicalIds(ids []string) map[string]string {
+	canonical := make(map[string]string, len(ids))
+	for _, id := range ids {
+		canonical[strings.ToLower(id)] = id
+	}
+	return canonical
+}
+
+func licenseCategoryNames() []string {
+	names := make([]string, 0, len(licenseCategories))
+	for name := range licenseCategories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// licenseCategory returns the category of a license identifier, if any
+func licenseCategory(license string) string {
+	for _, name := range licenseCategoryNames() {
+		for _, id := range licenseCategories[name] {
+			if strings.EqualFold(id, license) {
+				return name
+			}
+		}
+	}
+	return ""
+}
+
+// scannedLicenseCategory returns the category shared by all the licenses of a scanned license expression
+func scannedLicenseCategory(license string) string {
+	e, err := parseScannedLicenseExpression(license)
+	if err != nil {
+		return "uncategorized"
+	}
+	category := ""
+	var walk func(e *licenseExpression) bool
+	walk = func(e *licenseExpression) bool {
+		if e.operator == "" {
+			c := licenseCategory(e.license)
+			if c == "" || (category != "" && c != category) {
+				return false
+			}
+			category = c
+			return true
+		}
+		for _, operand := range e.operands {
+			if !walk(operand) {
+				return false
+			}
+		}
+		return true
+	}
+	if !walk(e) {
+		return "uncategorized"
+	}
+	return category
+}
+
+// licenseExpression is a parsed SPDX license expression, either a license, optionally with an exception, or the
+// AND or OR of operands
+type licenseExpression struct {
+	operator  string
+	license   string
+	exception string
+	operands  []*licenseExpression
+}
+
+func (e *licenseExpression) String() string {
+	if e.operator == "" {
+		if e.exception != "" {
+			return e.license + " WITH " + e.exception
+		}
+		return e.license
+	}
+	operands := make([]string, len(e.operands))
+	for i, operand := range e.operands {
+		operands[i] = operand.String()
+		if operand.operator != "" {
+			operands[i] = "(" + operands[i] + ")"
+		}
+	}
+	return strings.Join(operands, " "+e.operator+" ")
+}
+
+// parseLicenseExpression parses an SPDX license expression such as "MIT OR (GPL-2.0-only WITH Classpath-exception-2.0)".
+// Licenses are SPDX identifiers, optionally followed by +, or LicenseRef- references for licenses without one.
+func parseLicenseExpression(expression string) (*licenseExpression, error) {
+	return parseLicenseExpressionMode(expression, false)
+}
+
+// parseScannedLicenseExpression parses the license expression of a scanned package, whose licenses are not checked
+// against the SPDX identifiers
+func parseScannedLicenseExpression(expression string) (*licenseExpression, error) {
+	return parseLicenseExpressionMode(expression, true)
+}
+
+func parseLicenseExpressionMode(expression string, lenient bool) (*licenseExpression, error) {
+	p := licenseExpressionParser{tokens: tokenizeLicenseExpression(expression), lenient: lenient}
+	if len(p.tokens) == 0 {
+		return nil, fmt.Errorf("invalid license expression %q: empty expression", expression)
+	}
+	e, err := p.parseOr()
+	if err == nil && p.pos < len(p.tokens) {
+		err = fmt.Errorf("unexpected %q", p.tokens[p.pos])
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid license expression %q: %v", expression, err)
+	}
+	return e, nil
+}
+
+func tokenizeLicenseExpression(expression string) []string {
+	return strings.Fields(strings.NewReplacer("(", " ( ", ")", " ) ").Replace(expression))
+}
+
+type licenseExpressionParser struct {
+	tokens  []string
+	pos     int
+	lenient bool
+}
+
+func (p *licenseExpressionParser) next() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+// operator reports whether the next token is the operator, which is either upper or lower case
+func (p *licenseExpressionParser) operator(operator string) bool {
+	token := p.next()
+	if token == operator || token == strings.ToLower(operator) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *licenseExpressionParser) parseOr() (*licenseExpression, error) {
+	return p.parseOperands("OR", p.parseAnd)
+}
+
+func (p *licenseExpressionParser) parseAnd() (*licenseExpression, error) {
+	return p.parseOperands("AND", p.parseTerm)
+}
+
+func (p *licenseExpressionParser) parseOperands(operator string, parse func() (*licenseExpression, error)) (*licenseExpression, error) {
+	operand, err := parse()
+	if err != nil {
+		return nil, err
+	}
+	operands := []*licenseExpression{operand}
+	for p.operator(operator) {
+		operand, err := parse()
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, operand)
+	}
+	if len(operands) == 1 {
+		return operand, nil
+	}
+	return &licenseExpression{operator: operator, operands: operands}, nil
+}
+
+func (p *licenseExpressionParser) parseTerm() (*licenseExpression, error) {
+	token := p.next()
+	switch {
+	case token == "":
+		return nil, fmt.Errorf("missing license at the end of the expression")
+	case token == "(":
+		p.pos++
+		e, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.next() != ")" {
+			return nil, fmt.Errorf("missing closing parenthesis")
+		}
+		p.pos++
+		return e, nil
+	case token == ")" || isLicenseOperator(token):
+		return nil, fmt.Errorf("unexpected %q, expected a license", token)
+	}
+
+	p.pos++
+	e := &licenseExpression{license: token}
+	if !p.lenient {
+		license, err := canonicalLicense(token)
+		if err != nil {
+			return nil, err
+		}
+		e.license = license
+	}
+	if p.operator("WITH") {
+		exception := p.next()
+		if exception == "" || exception == "(" || exception == ")" || isLicenseOperator(exception) {
+			return nil, fmt.Errorf("missing license exception after WITH")
+		}
+		p.pos++
+		if p.lenient {
+			e.exception = exception
+			return e, nil
+		}
+		canonical, ok := spdxLicenseExceptions[strings.ToLower(exception)]
+		if !ok {
+			return nil, fmt.Errorf("unknown SPDX license exception %q (SPDX License List %s)", exception, spdxLicenseListVersion)
+		}
+		if canonical != exception {
+			return nil, fmt.Errorf("license exception %q must be written %q", exception, canonical)
+		}
+		e.exception = canonical
+	}
+	return e, nil
+}
+
+func isLicenseOperator(token string) bool {
+	for _, operator := range []string{"AND", "OR", "WITH"} {
+		if token == operator || token == strings.ToLower(operator) {
+			return true
+		}
+	}
+	return false
+}
+
+// canonicalLicense checks a license of an expression. Identifiers must be written in their canonical case so that
+// they match the licenses reported by the scans.
+func canonicalLicense(license string) (string, error) {
+	if licenseRefPattern.MatchString(license) {
+		return license, nil
+	}
+	if !licenseIdPattern.MatchString(license) {
+		return "", fmt.Errorf("invalid license %q", license)
+	}
+	id := strings.TrimSuffix(license, "+")
+	canonical, ok := spdxLicenses[strings.ToLower(id)]
+	if !ok {
+		return "", fmt.Errorf("unknown SPDX license identifier %q (SPDX License List %s), licenses without an SPDX identifier are written LicenseRef-<name>", license, spdxLicenseListVersion)
+	}
+	if canonical != id {
+		return "", fmt.Errorf("license %q must be written %q", license, canonical+strings.TrimPrefix(license, id))
+	}
+	return license, nil
+}
+
+func validateLicenseExpression(v interface{}, k string) ([]string, []error) {
+	if _, err := parseLicenseExpression(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%s: %v", k, err)}
+	}
+	return nil, nil
+}
+
+// mergeLicenseCategories adds the licenses of the categories to the licenses, without duplicates
+func mergeLicenseCategories(licenses, categories []string) []string {
+	merged := append([]string{}, licenses...)
+	for _, category := range categories {
+		for _, license := range licenseCategories[category] {
+			if !containsStringEntry(merged, license) {
+				merged = append(merged, license)
+			}
+		}
+	}
+	return merged
+}
+
+// validateLicenseLists checks that no license is both denied and allowed
+func validateLicenseLists(blackList, whiteList []string) error {
+	for _, license := range blackList {
+		if containsStringEntry(whiteList, license) {
+			return fmt.Errorf("license %q is in both blacklisted_licenses and whitelisted_licenses", license)
+		}
+	}
+	return nil
+}
+
+// licenseListsCustomizeDiff expands the license categories into the license lists so that the plan shows the
+// licenses they stand for, and checks that no license is both denied and allowed
+func licenseListsCustomizeDiff(d *schema.ResourceDiff) error {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+	lists := make(map[string][]string)
+	for _, key := range []string{"blacklisted_licenses", "whitelisted_licenses"} {
+		if !d.NewValueKnown(key+"_categories") || !rawConfig.GetAttr(key).IsWhollyKnown() {
+			return nil
+		}
+		// the licenses of a base policy are kept unless the list is configured
+		if d.Get("base_policy").(string) != "" && !isAttributeConfigured(rawConfig, key) && !isAttributeConfigured(rawConfig, key+"_categories") {
+			lists[key] = convertStringArr(d.Get(key).([]interface{}))
+			continue
+		}
+
+		var licenses []string
+		if v := rawConfig.GetAttr(key); !v.IsNull() {
+			for _, license := range v.AsValueSlice() {
+				if !license.IsNull() {
+					licenses = append(licenses, license.AsString())
+				}
+			}
+		}
+		lists[key] = mergeLicenseCategories(licenses, convertStringArr(d.Get(key+"_categories").([]interface{})))
+		if err := d.SetNew(key, lists[key]); err != nil {
+			return err
+		}
+	}
+	return validateLicenseLists(lists["blacklisted_licenses"], lists["whitelisted_licenses"])
+}
+
+// licenseCategoriesSchema returns the schema of the categories attribute of a license list
+func licenseCategoriesSchema(listKey string) *schema.Schema {
+	return &schema.Schema{
+		Type: schema.TypeList,
+		Description: fmt.Sprintf("License categories (any of '%s') whose SPDX license identifiers are added to `%s` at plan time. ", strings.Join(licenseCategoryNames(), "', '"), listKey) +
+			"Copyleft includes the weak copyleft licenses, proprietary the source available and non-commercial licenses.",
+		Optional: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice(licenseCategoryNames(), false),
+		},
+	}
+}
+
+// licenseMatcher matches the license expressions of packages against a license list
+type licenseMatcher struct {
+	entries map[string]bool
+}
+
+func newLicenseMatcher(licenses []string) *licenseMatcher {
+	m := licenseMatcher{entries: make(map[string]bool, len(licenses))}
+	for _, license := range licenses {
+		m.entries[normalizeLicense(license)] = true
+	}
+	return &m
+}
+
+// normalizeLicense returns the lower case canonical form of a license expression, or the lower case license when
+// it is not one
+func normalizeLicense(license string) string {
+	if e, err := parseScannedLicenseExpression(license); err == nil {
+		return strings.ToLower(e.String())
+	}
+	return strings.ToLower(strings.TrimSpace(license))
+}
+
+// denied reports whether every choice of licenses the expression offers includes a license of the list
+func (m *licenseMatcher) denied(license string) bool {
+	if m.entries[normalizeLicense(license)] {
+		return true
+	}
+	e, err := parseScannedLicenseExpression(license)
+	if err != nil {
+		return false
+	}
+	return m.evaluate(e, false)
+}
+
+// allowed reports whether a choice of licenses the expression offers only includes licenses of the list
+func (m *licenseMatcher) allowed(license string) bool {
+	if m.entries[normalizeLicense(license)] {
+		return true
+	}
+	e, err := parseScannedLicenseExpression(license)
+	if err != nil {
+		return false
+	}
+	return m.evaluate(e, true)
+}
+
+// evaluate matches the licenses of the expression. A license with an exception only matches entries with the same
+// exception. When every is set, AND requires all its operands to match and OR any of them, the other way around
+// otherwise.
+func (m *licenseMatcher) evaluate(e *licenseExpression, every bool) bool {
+	if e.operator == "" {
+		return m.entries[strings.ToLower(e.String())]
+	}
+	all := (e.operator == "AND") == every
+	for _, operand := range e.operands {
+		if m.evaluate(operand, every) != all {
+			return !all
+		}
+	}
+	return all
+}
@@ -0,0 +1,120 @@
+package khulnasoft
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseLicenseExpression(t *testing.T) {
+	cases := []struct {
+		expression string
+		canonical  string
+		err        string
+	}{
+		{expression: "MIT", canonical: "MIT"},
+		{expression: "GPL-2.0+", canonical: "GPL-2.0+"},
+		{expression: "MIT OR Apache-2.0", canonical: "MIT OR Apache-2.0"},
+		{expression: "mit or Apache-2.0", err: `license "mit" must be written "MIT"`},
+		{expression: "(MIT AND BSD-3-Clause) or GPL-2.0-only WITH Classpath-exception-2.0", canonical: "(MIT AND BSD-3-Clause) OR GPL-2.0-only WITH Classpath-exception-2.0"},
+		{expression: "LicenseRef-Acme-EULA AND MIT", canonical: "LicenseRef-Acme-EULA AND MIT"},
+		{expression: "DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2", canonical: "DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2"},
+		{expression: "NTP OR Zend-2.0", canonical: "NTP OR Zend-2.0"},
+		{expression: "OLDAP-2.8 AND Artistic-1.0-cl8", canonical: "OLDAP-2.8 AND Artistic-1.0-cl8"},
+		{expression: "GPL-2.0-or-later WITH Bootloader-exception", canonical: "GPL-2.0-or-later WITH Bootloader-exception"},
+		{expression: "Acme-EULA", err: `unknown SPDX license identifier "Acme-EULA"`},
+		{expression: "GPL-2.0-only WITH Foo-exception", err: `unknown SPDX license exception "Foo-exception"`},
+		{expression: "GPL-2.0-only WITH", err: "missing license exception after WITH"},
+		{expression: "(MIT OR Apache-2.0", err: "missing closing parenthesis"},
+		{expression: "MIT Apache-2.0", err: `unexpected "Apache-2.0"`},
+		{expression: "MIT AND", err: "missing license at the end of the expression"},
+		{expression: "OR MIT", err: `unexpected "OR", expected a license`},
+		{expression: "MIT/X11", err: `invalid license "MIT/X11"`},
+		{expression: " ", err: "empty expression"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.expression, func(t *testing.T) {
+			e, err := parseLicenseExpression(tc.expression)
+			if tc.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Errorf("expected an error containing %q, got %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if e.String() != tc.canonical {
+				t.Errorf("expected %q, got %q", tc.canonical, e.String())
+			}
+		})
+	}
+}
+
+func TestLicenseCategories(t *testing.T) {
+	for _, name := range licenseCategoryNames() {
+		for _, license := range licenseCategories[name] {
+			if _, err := parseLicenseExpression(license); err != nil {
+				t.Errorf("%s: %v", name, err)
+			}
+			if category := licenseCategory(license); category != name {
+				t.Errorf("expected %s to be %s, got %s", license, name, category)
+			}
+		}
+	}
+
+	licenses := mergeLicenseCategories([]string{"LicenseRef-Acme-EULA", "SSPL-1.0"}, []string{"proprietary"})
+	if licenses[0] != "LicenseRef-Acme-EULA" || licenses[1] != "SSPL-1.0" || len(licenses) != len(licenseCategories["proprietary"])+1 {
+		t.Errorf("expected the proprietary licenses after the listed licenses without duplicates, got %v", licenses)
+	}
+	if err := validateLicenseLists(licenses, mergeLicenseCategories(nil, []string{"permissive"})); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if err := validateLicenseLists([]string{"MIT"}, mergeLicenseCategories(nil, []string{"permissive"})); err == nil {
+		t.Error("expected an error for a license both denied and allowed")
+	}
+}
+
+func TestLicenseMatcher(t *testing.T) {
+	blackList := newLicenseMatcher([]string{"GPL-3.0-only", "AGPL-3.0-only", "LicenseRef-Acme-EULA"})
+	whiteList := newLicenseMatcher([]string{"MIT", "Apache-2.0", "GPL-2.0-only WITH Classpath-exception-2.0"})
+
+	cases := []struct {
+		license string
+		denied  bool
+		allowed bool
+	}{
+		{license: "MIT", allowed: true},
+		{license: "mit", allowed: true},
+		{license: "GPL-3.0-only", denied: true},
+		{license: "MIT OR GPL-3.0-only", allowed: true},
+		{license: "MIT AND GPL-3.0-only", denied: true},
+		{license: "GPL-3.0-only OR AGPL-3.0-only", denied: true},
+		{license: "(MIT AND Apache-2.0) OR GPL-3.0-only", allowed: true},
+		{license: "GPL-2.0-only WITH Classpath-exception-2.0", allowed: true},
+		{license: "GPL-2.0-only"},
+		{license: "LicenseRef-Acme-EULA", denied: true},
+		{license: "BSD-like"},
+	}
+
+	for _, tc := range cases {
+		if denied := blackList.denied(tc.license); denied != tc.denied {
+			t.Errorf("expected %q denied to be %v, got %v", tc.license, tc.denied, denied)
+		}
+		if allowed := whiteList.allowed(tc.license); allowed != tc.allowed {
+			t.Errorf("expected %q allowed to be %v, got %v", tc.license, tc.allowed, allowed)
+		}
+	}
+}
+
+func TestScannedLicenseCategory(t *testing.T) {
+	var categories []string
+	for _, license := range []string{"MIT", "MIT OR Apache-2.0", "MIT OR GPL-2.0-only", "gpl-2.0+", "SSPL-1.0", "BSD-like", "GPL v2"} {
+		categories = append(categories, scannedLicenseCategory(license))
+	}
+	expected := []string{"permissive", "permissive", "uncategorized", "copyleft", "proprietary", "uncategorized", "uncategorized"}
+	if !reflect.DeepEqual(categories, expected) {
+		t.Errorf("expected %v, got %v", expected, categories)
+	}
+}
@@ -0,0 +1,558 @@
+package khulnasoft
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// packageFormats are the package ecosystems of the packages_black_list and packages_white_list entries
+var packageFormats = []string{"apk", "cargo", "composer", "deb", "gem", "go", "jar", "npm", "nuget", "pypi", "rpm"}
+
+// packageFormatFields are the entry attributes only meaningful for the packages of some ecosystems
+var packageFormatFields = map[string][]string{
+	"epoch":   {"rpm", "deb"},
+	"release": {"rpm"},
+	"arch":    {"rpm", "deb", "apk"},
+}
+
+var validatePackageFormat = validation.StringInSlice(packageFormats, false)
+
+var packageVersionPattern = regexp.MustCompile(`^[A-Za-z0-9*][A-Za-z0-9._+:~*-]*$`)
+
+var packageRangeOperators = []string{"", "=", "==", "!=", "<", "<=", ">", ">=", "~", "~=", "^"}
+
+// packageListFileColumns are the columns of a package list CSV file, and the keys of the objects of a JSON file
+var packageListFileColumns = []string{"format", "name", "epoch", "version", "version_range", "release", "arch", "license", "display"}
+
+// packageConstraint is a constraint of a version range such as >= 1.2.0
+type packageConstraint struct {
+	operator string
+	version  string
+}
+
+// parsePackageVersionRange parses a version range, a list of alternatives separated by || each made of constraints
+// separated by commas or spaces, e.g. ">=1.2.0, <2.0.0 || >=3.0.0". The constraints of each alternative must be
+// satisfiable together.
+func parsePackageVersionRange(versionRange string) ([][]packageConstraint, error) {
+	if strings.TrimSpace(versionRange) == "" {
+		return nil, fmt.Errorf("invalid version range %q: empty range", versionRange)
+	}
+
+	var alternatives [][]packageConstraint
+	for _, alternative := range strings.Split(versionRange, "||") {
+		constraints, err := parsePackageConstraints(alternative)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version range %q: %v", versionRange, err)
+		}
+		if err := checkPackageConstraints(constraints); err != nil {
+			return nil, fmt.Errorf("invalid version range %q: %v", versionRange, err)
+		}
+		alternatives = append(alternatives, constraints)
+	}
+	return alternatives, nil
+}
+
+func parsePackageConstraints(alternative string) ([]packageConstraint, error) {
+	var constraints []packageConstraint
+	isSeparator := func(c byte) bool { return c == ' ' || c == '\t' || c == ',' }
+	isOperator := func(c byte) bool { return strings.IndexByte("<>=!~^", c) >= 0 }
+
+	for i := 0; i < len(alternative); {
+		if isSeparator(alternative[i]) {
+			i++
+			continue
+		}
+		start := i
+		for i < len(alternative) && isOperator(alternative[i]) {
+			i++
+		}
+		operator := alternative[start:i]
+		if !containsStringEntry(packageRangeOperators, operator) {
+			return nil, fmt.Errorf("unknown operator %q", operator)
+		}
+		for i < len(alternative) && (alternative[i] == ' ' || alternative[i] == '\t') {
+			i++
+		}
+		start = i
+		for i < len(alternative) && !isSeparator(alternative[i]) {
+			i++
+		}
+		version := alternative[start:i]
+		if version == "" {
+			return nil, fmt.Errorf("missing version after %q", operator)
+		}
+		if !packageVersionPattern.MatchString(version) {
+			return nil, fmt.Errorf("invalid version %q", version)
+		}
+		constraints = append(constraints, packageConstraint{operator: operator, version: version})
+	}
+	if len(constraints) == 0 {
+		return nil, fmt.Errorf("empty alternative")
+	}
+	return constraints, nil
+}
+
+// checkPackageConstraints reports constraints that no version satisfies, such as >= 2.0 < 1.0. A version with
+// wildcards such as 1.2.* bounds the range by the versions it matches, >= 1.2 < 1.3.
+func checkPackageConstraints(constraints []packageConstraint) error {
+	var lower, upper *packageConstraint
+	for i, c := range constraints {
+		if strings.Contains(c.version, "*") {
+			if c.operator == "" || c.operator == "=" || c.operator == "==" {
+				prefixLower, prefixUpper := wildcardPackageRange(c.version)
+				lower, upper = tighterPackageBound(lower, prefixLower, 1), tighterPackageBound(upper, prefixUpper, -1)
+			}
+			continue
+		}
+		switch c.operator {
+		case ">", ">=":
+			if lower == nil || comparePackageVersions(c.version, lower.version) > 0 {
+				lower = &constraints[i]
+			}
+		case "<", "<=":
+			if upper == nil || comparePackageVersions(c.version, upper.version) < 0 {
+				upper = &constraints[i]
+			}
+		case "", "=", "==":
+			lower, upper = pickPackageBound(lower, &constraints[i], 1), pickPackageBound(upper, &constraints[i], -1)
+		}
+	}
+	if lower == nil || upper == nil {
+		return nil
+	}
+	cmp := comparePackageVersions(lower.version, upper.version)
+	if cmp > 0 || (cmp == 0 && (lower.operator == ">" || upper.operator == "<")) {
+		return fmt.Errorf("no version is %s%s and %s%s", lower.operator, lower.version, upper.operator, upper.version)
+	}
+	return nil
+}
+
+// pickPackageBound returns the tighter of a bound and an exact version constraint, the lower bound for direction 1
+// and the upper bound for direction -1
+func pickPackageBound(bound, exact *packageConstraint, direction int) *packageConstraint {
+	if bound == nil || comparePackageVersions(exact.version, bound.version)*direction >= 0 {
+		return &packageConstraint{operator: map[int]string{1: ">=", -1: "<="}[direction], version: exact.version}
+	}
+	return bound
+}
+
+// wildcardPackageRange returns the bounds of the versions matched by a version ending with wildcards, e.g. >= 1.2
+// and < 1.3 for 1.2.*. The bounds are nil when the version has no prefix or has wildcards before its last segment,
+// the upper bound when the last segment of the prefix isn't numeric.
+func wildcardPackageRange(version string) (*packageConstraint, *packageConstraint) {
+	i := strings.Index(version, "*")
+	prefix := strings.TrimRight(version[:i], ".-")
+	if prefix == "" || strings.Trim(version[i:], ".*") != "" {
+		return nil, nil
+	}
+	lower := &packageConstraint{operator: ">=", version: prefix}
+	last := strings.LastIndexAny(prefix, ".-") + 1
+	n, err := strconv.Atoi(prefix[last:])
+	if err != nil {
+		return lower, nil
+	}
+	return lower, &packageConstraint{operator: "<", version: prefix[:last] + strconv.Itoa(n+1)}
+}
+
+// tighterPackageBound returns the tighter of two bounds, either of which may be nil, the higher lower bound for
+// direction 1 and the lower upper bound for direction -1
+func tighterPackageBound(bound, other *packageConstraint, direction int) *packageConstraint {
+	if bound == nil || (other != nil && comparePackageVersions(other.version, bound.version)*direction > 0) {
+		return other
+	}
+	return bound
+}
+
+// comparePackageVersions compares two versions segment by segment, numeric segments numerically and the others
+// lexically, which orders the versions of every supported ecosystem well enough to validate ranges
+func comparePackageVersions(a, b string) int {
+	as, bs := splitPackageVersion(a), splitPackageVersion(b)
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		if i >= len(as) {
+			return -1
+		}
+		if i >= len(bs) {
+			return 1
+		}
+		an, aerr := strconv.Atoi(as[i])
+		bn, berr := strconv.Atoi(bs[i])
+		switch {
+		case aerr == nil && berr == nil:
+			if an != bn {
+				if an < bn {
+					return -1
+				}
+				return 1
+			}
+		case as[i] != bs[i]:
+			if as[i] < bs[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+func splitPackageVersion(version string) []string {
+	var segments []string
+	var current []rune
+	flush := func() {
+		if len(current) > 0 {
+			segments = append(segments, string(current))
+			current = nil
+		}
+	}
+	for _, r := range version {
+		switch {
+		case strings.ContainsRune(".-+:~_", r):
+			flush()
+		case len(current) > 0 && unicode.IsDigit(r) != unicode.IsDigit(current[len(current)-1]):
+			flush()
+			current = append(current, r)
+		default:
+			current = append(current, r)
+		}
+	}
+	flush()
+	return segments
+}
+
+// matchPackageVersionRange reports whether a version is in a version range. ~ matches the versions with the same
+// leading segments except the last one of the constraint, ^ the versions with the same first segment.
+func matchPackageVersionRange(versionRange, version string) bool {
+	alternatives, err := parsePackageVersionRange(versionRange)
+	if err != nil {
+		return false
+	}
+	for _, constraints := range alternatives {
+		match := true
+		for _, c := range constraints {
+			if !matchPackageConstraint(c, version) {
+				match = false
+				break
+			}
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
+
+func matchPackageConstraint(c packageConstraint, version string) bool {
+	cmp := comparePackageVersions(version, c.version)
+	switch c.operator {
+	case "", "=", "==":
+		return matchScopeValue(c.version, version)
+	case "!=":
+		return !matchScopeValue(c.version, version)
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	case "~", "~=":
+		prefix := splitPackageVersion(c.version)
+		if len(prefix) > 1 {
+			prefix = prefix[:len(prefix)-1]
+		}
+		return cmp >= 0 && hasPackageVersionPrefix(version, prefix)
+	case "^":
+		return cmp >= 0 && hasPackageVersionPrefix(version, splitPackageVersion(c.version)[:1])
+	}
+	return false
+}
+
+func hasPackageVersionPrefix(version string, prefix []string) bool {
+	segments := splitPackageVersion(version)
+	if len(segments) < len(prefix) {
+		return false
+	}
+	for i := range prefix {
+		if segments[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// validatePackageVersionRange validates the syntax of a version_range
+func validatePackageVersionRange(v interface{}, k string) ([]string, []error) {
+	if v.(string) == "" {
+		return nil, nil
+	}
+	if _, err := parsePackageVersionRange(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%q: %v", k, err)}
+	}
+	return nil, nil
+}
+
+// validatePackage validates what the schema of a package list entry cannot: the attributes required by and specific
+// to its ecosystem
+func validatePackage(p client.ListPackages) error {
+	if p.Name == "" {
+		return fmt.Errorf("package %s has no name", packageDisplayName(p))
+	}
+	if p.Format != "" && !containsStringEntry(packageFormats, p.Format) {
+		return fmt.Errorf("package %s has unknown format %q, expected one of %s", packageDisplayName(p), p.Format, strings.Join(packageFormats, ", "))
+	}
+	if p.Version != "" && p.VersionRange != "" {
+		return fmt.Errorf("package %s sets both version and version_range", packageDisplayName(p))
+	}
+	if p.Version != "" && !packageVersionPattern.MatchString(p.Version) {
+		return fmt.Errorf("package %s has invalid version %q", packageDisplayName(p), p.Version)
+	}
+	if p.VersionRange != "" {
+		if _, err := parsePackageVersionRange(p.VersionRange); err != nil {
+			return fmt.Errorf("package %s: %v", packageDisplayName(p), err)
+		}
+	}
+	values := map[string]string{"epoch": p.Epoch, "release": p.Release, "arch": p.Arch}
+	for _, field := range []string{"epoch", "release", "arch"} {
+		if values[field] != "" && !containsStringEntry(packageFormatFields[field], p.Format) {
+			return fmt.Errorf("package %s sets %s, which only applies to the %s formats", packageDisplayName(p), field, strings.Join(packageFormatFields[field], ", "))
+		}
+	}
+	if p.Epoch != "" {
+		if _, err := strconv.Atoi(p.Epoch); err != nil {
+			return fmt.Errorf("package %s has invalid epoch %q, expected a number", packageDisplayName(p), p.Epoch)
+		}
+	}
+	return nil
+}
+
+// packageKey identifies the packages an entry matches, ignoring its license and display name
+func packageKey(p client.ListPackages) string {
+	return strings.Join([]string{p.Format, p.Name, p.Epoch, p.Version, p.VersionRange, p.Release, p.Arch}, "\x00")
+}
+
+func packageDisplayName(p client.ListPackages) string {
+	name := p.Name
+	if p.Format != "" {
+		name = p.Format + ":" + name
+	}
+	if p.Version != "" {
+		name += "@" + p.Version
+	}
+	if p.VersionRange != "" {
+		name += "@" + p.VersionRange
+	}
+	return fmt.Sprintf("%q", name)
+}
+
+// validatePackageLists validates the entries of the package deny and allow lists, and reports duplicate entries and
+// packages both denied and allowed
+func validatePackageLists(blackList, whiteList []client.ListPackages) error {
+	lists := []struct {
+		key      string
+		packages []client.ListPackages
+	}{
+		{"packages_black_list", blackList},
+		{"packages_white_list", whiteList},
+	}
+	listed := make(map[string]string)
+	for _, list := range lists {
+		seen := make(map[string]bool)
+		for _, p := range list.packages {
+			if err := validatePackage(p); err != nil {
+				return fmt.Errorf("%s: %v", list.key, err)
+			}
+			key := packageKey(p)
+			if seen[key] {
+				return fmt.Errorf("%s: package %s is listed more than once", list.key, packageDisplayName(p))
+			}
+			seen[key] = true
+			if other, ok := listed[key]; ok {
+				return fmt.Errorf("package %s is in both %s and %s", packageDisplayName(p), other, list.key)
+			}
+			listed[key] = list.key
+		}
+	}
+	return nil
+}
+
+func expandPackages(packages []interface{}) []client.ListPackages {
+	result := make([]client.ListPackages, len(packages))
+	for i, Data := range packages {
+		p := Data.(map[string]interface{})
+		result[i] = client.ListPackages{
+			Format:       p["format"].(string),
+			Name:         p["name"].(string),
+			Epoch:        p["epoch"].(string),
+			Version:      p["version"].(string),
+			VersionRange: p["version_range"].(string),
+			Release:      p["release"].(string),
+			Arch:         p["arch"].(string),
+			License:      p["license"].(string),
+			Display:      p["display"].(string),
+		}
+	}
+	return result
+}
+
+// loadPackageListFile loads package list entries from a CSV file with a header row naming the columns, or from a
+// JSON file holding an array of entries, using the attribute names of the entries
+func loadPackageListFile(path string) ([]client.ListPackages, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading package list file: %v", err)
+	}
+
+	var packages []client.ListPackages
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		decoder := json.NewDecoder(bytes.NewReader(content))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&packages); err != nil {
+			return nil, fmt.Errorf("invalid package list file %s: %v", path, err)
+		}
+	case ".csv":
+		packages, err = parsePackageListCSV(content)
+		if err != nil {
+			return nil, fmt.Errorf("invalid package list file %s: %v", path, err)
+		}
+	default:
+		return nil, fmt.Errorf("package list file %s must be a .csv or a .json file", path)
+	}
+	return packages, nil
+}
+
+func parsePackageListCSV(content []byte) ([]client.ListPackages, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.Comment = '#'
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("missing header row: %v", err)
+	}
+	for i, column := range header {
+		header[i] = strings.TrimSpace(column)
+		if !containsStringEntry(packageListFileColumns, header[i]) {
+			return nil, fmt.Errorf("unknown column %q, expected columns among %s", column, strings.Join(packageListFileColumns, ", "))
+		}
+	}
+
+	var packages []client.ListPackages
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		entry := make(map[string]interface{}, len(packageListFileColumns))
+		for _, column := range packageListFileColumns {
+			entry[column] = ""
+		}
+		for i, value := range record {
+			entry[header[i]] = strings.TrimSpace(value)
+		}
+		packages = append(packages, expandPackages([]interface{}{entry})...)
+	}
+	return packages, nil
+}
+
+// applyPackageListFiles adds the entries of packages_black_list_file and packages_white_list_file to the package
+// lists of an assurance policy
+func applyPackageListFiles(d *schema.ResourceData, iap *client.AssurancePolicy) error {
+	for _, list := range []struct {
+		fileKey  string
+		packages *[]client.ListPackages
+	}{
+		{"packages_black_list_file", &iap.PackagesBlackList},
+		{"packages_white_list_file", &iap.PackagesWhiteList},
+	} {
+		file, ok := d.GetOk(list.fileKey)
+		if !ok {
+			continue
+		}
+		packages, err := loadPackageListFile(file.(string))
+		if err != nil {
+			return fmt.Errorf("%s: %v", list.fileKey, err)
+		}
+		*list.packages = append(*list.packages, packages...)
+	}
+	return validatePackageLists(iap.PackagesBlackList, iap.PackagesWhiteList)
+}
+
+// flattenPackageList flattens the packages of a package list that are not loaded from its file. The file attribute is
+// cleared when the console lacks some of the packages of the file, so that the missing packages show up as a diff.
+func flattenPackageList(d *schema.ResourceData, fileKey string, packages []client.ListPackages) []map[string]interface{} {
+	file, ok := d.GetOk(fileKey)
+	if !ok {
+		return flattenPackages(packages)
+	}
+	filePackages, err := loadPackageListFile(file.(string))
+	if err != nil {
+		log.Printf("[WARN] %s: %v", fileKey, err)
+		return flattenPackages(packages)
+	}
+
+	fromFile := make(map[string]bool, len(filePackages))
+	for _, p := range filePackages {
+		fromFile[packageKey(p)] = true
+	}
+	var inline []client.ListPackages
+	for _, p := range packages {
+		if fromFile[packageKey(p)] {
+			delete(fromFile, packageKey(p))
+			continue
+		}
+		inline = append(inline, p)
+	}
+	if len(fromFile) > 0 {
+		d.Set(fileKey, "")
+	}
+	return flattenPackages(inline)
+}
+
+// validatePackageListsDiff validates the package lists of a plan, including the entries of their files
+func validatePackageListsDiff(d *schema.ResourceDiff) error {
+	lists := make(map[string][]client.ListPackages)
+	for _, key := range []string{"packages_black_list", "packages_white_list"} {
+		if !d.NewValueKnown(key) || !d.NewValueKnown(key+"_file") {
+			return nil
+		}
+		if v, ok := d.GetOk(key); ok {
+			lists[key] = expandPackages(v.(*schema.Set).List())
+		}
+		if file, ok := d.GetOk(key + "_file"); ok {
+			packages, err := loadPackageListFile(file.(string))
+			if err != nil {
+				return fmt.Errorf("%s_file: %v", key, err)
+			}
+			lists[key] = append(lists[key], packages...)
+		}
+	}
+	return validatePackageLists(lists["packages_black_list"], lists["packages_white_list"])
+}
+
+// packageListFileSchema returns the schema of the file attribute of a package list
+func packageListFileSchema(listKey string) *schema.Schema {
+	return &schema.Schema{
+		Type: schema.TypeString,
+		Description: fmt.Sprintf("Path of a CSV or JSON file holding entries added to `%s`. ", listKey) +
+			"A CSV file starts with a header row naming its columns, a JSON file holds an array of objects, both using the attribute names of the entries, e.g. `format`, `name` and `version_range`.",
+		Optional: true,
+	}
+}
@@ -0,0 +1,182 @@
+package khulnasoft
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
+)
+
+func TestParsePackageVersionRange(t *testing.T) {
+	cases := []struct {
+		versionRange string
+		err          string
+	}{
+		{versionRange: ">=1.2.0, <2.0.0"},
+		{versionRange: ">= 1.2.0 < 2.0.0"},
+		{versionRange: ">=1.2.0 <2.0.0 || >=3.0.0"},
+		{versionRange: "^1.2"},
+		{versionRange: "1.2.*"},
+		{versionRange: "=2.0.1, <=2.0.1"},
+		{versionRange: "1.*, >=1.5"},
+		{versionRange: ">1.2.3, 1.2.*"},
+		{versionRange: "1.*.3, <1.0"},
+		{versionRange: ">=1.10.0, <1.9.0", err: "no version is >=1.10.0 and <1.9.0"},
+		{versionRange: ">2.0 <2.0", err: "no version is >2.0 and <2.0"},
+		{versionRange: "=3.0, <2.0", err: "no version is >=3.0 and <2.0"},
+		{versionRange: "1.2.*, >=1.3", err: "no version is >=1.3 and <1.3"},
+		{versionRange: "1.*, <0.9", err: "no version is >=1 and <0.9"},
+		{versionRange: "=>1.0", err: `unknown operator "=>"`},
+		{versionRange: ">=", err: `missing version after ">="`},
+		{versionRange: ">=1.0 ||", err: "empty alternative"},
+		{versionRange: ">=1.0$", err: `invalid version "1.0$"`},
+		{versionRange: " ", err: "empty range"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.versionRange, func(t *testing.T) {
+			_, err := parsePackageVersionRange(tc.versionRange)
+			if tc.err == "" {
+				if err != nil {
+					t.Errorf("expected no error, got %v", err)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("expected an error containing %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestComparePackageVersions(t *testing.T) {
+	cases := []struct {
+		a, b string
+		cmp  int
+	}{
+		{"1.10.0", "1.9.0", 1},
+		{"1.2", "1.2.0", -1},
+		{"2.0.0-rc1", "2.0.0-rc2", -1},
+		{"1:2.3-4.el8", "1:2.3-4.el8", 0},
+		{"1.0a", "1.0b", -1},
+	}
+
+	for _, tc := range cases {
+		if cmp := comparePackageVersions(tc.a, tc.b); cmp != tc.cmp {
+			t.Errorf("expected %s compared to %s to be %d, got %d", tc.a, tc.b, tc.cmp, cmp)
+		}
+	}
+}
+
+func TestValidatePackageLists(t *testing.T) {
+	cases := []struct {
+		name      string
+		blackList []client.ListPackages
+		whiteList []client.ListPackages
+		err       string
+	}{
+		{
+			name:      "valid",
+			blackList: []client.ListPackages{{Format: "rpm", Name: "openssl", Epoch: "1", Version: "1.1.1k", Release: "4.el8", Arch: "x86_64"}},
+			whiteList: []client.ListPackages{{Format: "npm", Name: "lodash", VersionRange: ">=4.17.21"}},
+		},
+		{
+			name:      "missing name",
+			blackList: []client.ListPackages{{Format: "deb", Version: "1.0"}},
+			err:       "packages_black_list: package \"deb:@1.0\" has no name",
+		},
+		{
+			name:      "version and version range",
+			whiteList: []client.ListPackages{{Format: "pypi", Name: "requests", Version: "2.31.0", VersionRange: ">=2.0"}},
+			err:       "sets both version and version_range",
+		},
+		{
+			name:      "epoch of an npm package",
+			whiteList: []client.ListPackages{{Format: "npm", Name: "lodash", Epoch: "1"}},
+			err:       "sets epoch, which only applies to the rpm, deb formats",
+		},
+		{
+			name:      "invalid epoch",
+			blackList: []client.ListPackages{{Format: "rpm", Name: "bash", Epoch: "one"}},
+			err:       `invalid epoch "one"`,
+		},
+		{
+			name:      "unknown format",
+			blackList: []client.ListPackages{{Format: "pip", Name: "requests"}},
+			err:       `unknown format "pip"`,
+		},
+		{
+			name: "duplicate",
+			blackList: []client.ListPackages{
+				{Format: "deb", Name: "curl", Version: "7.68.0"},
+				{Format: "deb", Name: "curl", Version: "7.68.0", License: "MIT"},
+			},
+			err: `packages_black_list: package "deb:curl@7.68.0" is listed more than once`,
+		},
+		{
+			name:      "denied and allowed",
+			blackList: []client.ListPackages{{Format: "gem", Name: "rails"}},
+			whiteList: []client.ListPackages{{Format: "gem", Name: "rails"}},
+			err:       `package "gem:rails" is in both packages_black_list and packages_white_list`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validatePackageLists(tc.blackList, tc.whiteList)
+			if tc.err == "" {
+				if err != nil {
+					t.Errorf("expected no error, got %v", err)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("expected an error containing %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestLoadPackageListFile(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	expected := []client.ListPackages{
+		{Format: "npm", Name: "lodash", VersionRange: ">=4.17.21"},
+		{Format: "rpm", Name: "openssl", Version: "1.1.1k", Arch: "x86_64"},
+	}
+
+	csvFile := write("packages.csv", "# allowed packages\nformat,name,version,version_range,arch\nnpm,lodash,,\">=4.17.21\",\nrpm, openssl,1.1.1k,,x86_64\n")
+	jsonFile := write("packages.json", `[{"format": "npm", "name": "lodash", "version_range": ">=4.17.21"}, {"format": "rpm", "name": "openssl", "version": "1.1.1k", "arch": "x86_64"}]`)
+	for _, file := range []string{csvFile, jsonFile} {
+		packages, err := loadPackageListFile(file)
+		if err != nil {
+			t.Fatalf("%s: %v", file, err)
+		}
+		if len(packages) != len(expected) {
+			t.Fatalf("%s: expected %d packages, got %d", file, len(expected), len(packages))
+		}
+		for i := range expected {
+			if packages[i] != expected[i] {
+				t.Errorf("%s: expected %+v, got %+v", file, expected[i], packages[i])
+			}
+		}
+	}
+
+	for _, tc := range []struct {
+		file string
+		err  string
+	}{
+		{write("unknown.csv", "format,name,vendor\nnpm,lodash,acme\n"), `unknown column "vendor"`},
+		{write("unknown.json", `[{"format": "npm", "name": "lodash", "vendor": "acme"}]`), `unknown field "vendor"`},
+		{write("packages.txt", "lodash"), "must be a .csv or a .json file"},
+		{filepath.Join(dir, "missing.csv"), "failed reading package list file"},
+	} {
+		if _, err := loadPackageListFile(tc.file); err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("%s: expected an error containing %q, got %v", tc.file, tc.err, err)
+		}
+	}
+}
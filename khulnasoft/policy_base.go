@@ -0,0 +1,333 @@
+package khulnasoft
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// runtimePolicyAttributeFields maps the runtime policy attributes that are not named after the console field they set
+var runtimePolicyAttributeFields = map[string][]string{
+	"scope_expression":                   {"scope"},
+	"scope_variables":                    {"scope"},
+	"scope_conditions":                   {"scope"},
+	"enforcement_rollout":                {"enforce", "enforce_after_days", "application_scopes"},
+	"block_cryptocurrency_mining":        {"enable_crypto_mining_dns"},
+	"blocked_files":                      {"file_block"},
+	"blocked_executables":                {"executable_blacklist"},
+	"blocked_packages":                   {"package_block"},
+	"blocked_volumes":                    {"restricted_volumes"},
+	"blocked_capabilities":               {"linux_capabilities"},
+	"blocked_inbound_ports":              {"port_block"},
+	"blocked_outbound_ports":             {"port_block"},
+	"container_exec_allowed_processes":   {"container_exec"},
+	"limit_new_privileges":               {"no_new_privileges"},
+	"audit_all_network_activity":         {"auditing"},
+	"audit_all_processes_activity":       {"auditing"},
+	"audit_full_command_arguments":       {"auditing"},
+	"audit_host_failed_login_events":     {"auditing"},
+	"audit_host_successful_login_events": {"auditing"},
+	"audit_user_account_management":      {"auditing"},
+	"block_access_host_network":          {"limit_container_privileges"},
+	"block_adding_capabilities":          {"limit_container_privileges"},
+	"block_low_port_binding":             {"limit_container_privileges"},
+	"block_privileged_containers":        {"limit_container_privileges"},
+	"block_root_user":                    {"limit_container_privileges"},
+	"block_use_ipc_namespace":            {"limit_container_privileges"},
+	"block_use_pid_namespace":            {"limit_container_privileges"},
+	"block_use_user_namespace":           {"limit_container_privileges"},
+	"block_use_uts_namespace":            {"limit_container_privileges"},
+	"monitor_system_log_integrity":       {"system_integrity_protection"},
+	"monitor_system_time_changes":        {"system_integrity_protection"},
+	"monitor_windows_services":           {"system_integrity_protection"},
+	"os_users_allowed":                   {"whitelisted_os_users"},
+	"os_groups_allowed":                  {"whitelisted_os_users"},
+	"os_users_blocked":                   {"blacklisted_os_users"},
+	"os_groups_blocked":                  {"blacklisted_os_users"},
+	"honeypot_access_key":                {"tripwire"},
+	"honeypot_secret_key":                {"tripwire"},
+	"honeypot_apply_on":                  {"tripwire"},
+	"honeypot_serverless_app_name":       {"tripwire"},
+}
+
+// assurancePolicyAttributeFields maps the assurance policy attributes that are not named after the console field they
+// set, an empty list marking the attributes that are not sent to the console
+var assurancePolicyAttributeFields = map[string][]string{
+	"aggregated_vulnerability":        {},
+	"auto_scan_schedule":              {"auto_scan_time"},
+	"blacklisted_licenses_categories": {"blacklisted_licenses"},
+	"cve_exceptions":                  {"cves_white_list"},
+	"whitelisted_licenses_categories": {"whitelisted_licenses"},
+	"scope_conditions":                {"scope"},
+	"packages_black_list_file":        {"packages_black_list"},
+	"packages_white_list_file":        {"packages_white_list"},
+	"trusted_base_images_sets":        {"trusted_base_images"},
+}
+
+// runtimePolicyIdentityFields are the runtime policy fields that always come from the resource, never from its base
+var runtimePolicyIdentityFields = []string{"name", "runtime_type"}
+
+// runtimePolicyServerFields are the runtime policy fields managed by the console, which are not copied from the base
+var runtimePolicyServerFields = append([]string{"is_ootb_policy", "is_auto_generated"}, runtimePolicyServerManagedFields...)
+
+// assurancePolicyIdentityFields are the assurance policy fields that always come from the resource, never from its base
+var assurancePolicyIdentityFields = []string{"name", "assurance_type"}
+
+// assurancePolicyServerFields are the assurance policy fields managed by the console, which are not copied from the base
+var assurancePolicyServerFields = []string{"id", "author", "lastupdate", "read_only"}
+
+func basePolicySchema(kind string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Name of an existing " + kind + " policy, e.g. one of the console defaults, used as the starting point of this policy: the attributes that are not set in the configuration keep the value of the base policy. The base policy is read again on every plan, and the policy is updated when the values it keeps from the base policy change.",
+		Optional:    true,
+	}
+}
+
+func basePolicyDigestSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Digest of the values of the base policy that are not overridden by the configuration. A change of the base policy, or an attribute no longer overriding it, changes the digest on the next plan and updates the policy.",
+		Computed:    true,
+	}
+}
+
+// addBasePolicySchema adds the base_policy attribute to a policy schema, and suppresses the diff of the attributes
+// that are not configured when a base policy is set, as their value comes from the base policy. The changes of the
+// base policy values are planned through base_policy_digest instead.
+func addBasePolicySchema(policySchema map[string]*schema.Schema, kind string) {
+	for name, attribute := range policySchema {
+		if name != "name" {
+			suppressBasePolicyDiff(attribute)
+		}
+	}
+	policySchema["base_policy"] = basePolicySchema(kind)
+	policySchema["base_policy_digest"] = basePolicyDigestSchema()
+}
+
+func suppressBasePolicyDiff(attribute *schema.Schema) {
+	if attribute.Computed && !attribute.Optional {
+		return
+	}
+	attribute.DiffSuppressFunc = basePolicyDiffSuppressFunc(attribute.DiffSuppressFunc)
+	// the diff of the attributes of a block is suppressed by their own schema
+	if elem, ok := attribute.Elem.(*schema.Resource); ok {
+		for _, nested := range elem.Schema {
+			suppressBasePolicyDiff(nested)
+		}
+	}
+}
+
+func basePolicyDiffSuppressFunc(next schema.SchemaDiffSuppressFunc) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		if d.Get("base_policy").(string) != "" && !isAttributeConfigured(d.GetRawConfig(), strings.Split(k, ".")[0]) {
+			return true
+		}
+		if next != nil {
+			return next(k, old, new, d)
+		}
+		return false
+	}
+}
+
+// applyBaseRuntimePolicy replaces the runtime policy with its base policy, if any, overridden by the configured attributes
+func applyBaseRuntimePolicy(d *schema.ResourceData, c *client.Client, crp *client.RuntimePolicy) error {
+	baseName, ok := d.GetOk("base_policy")
+	if !ok {
+		return nil
+	}
+
+	base, err := c.GetRuntimePolicy(baseName.(string))
+	if err != nil {
+		return err
+	}
+	if err := setBasePolicyDigest(d, base, runtimePolicyAttributeFields, runtimePolicyIdentityFields, runtimePolicyServerFields); err != nil {
+		return err
+	}
+	return mergeBasePolicy(d, base, crp, runtimePolicyAttributeFields, runtimePolicyIdentityFields, runtimePolicyServerFields)
+}
+
+// applyBaseAssurancePolicy replaces the assurance policy with its base policy, if any, overridden by the configured
+// attributes. The base policy is an assurance policy of the same type.
+func applyBaseAssurancePolicy(d *schema.ResourceData, c *client.Client, iap *client.AssurancePolicy, assuranceType string) error {
+	baseName, ok := d.GetOk("base_policy")
+	if !ok {
+		return nil
+	}
+
+	base, err := c.GetAssurancePolicy(baseName.(string), assuranceType)
+	if err != nil {
+		return err
+	}
+	if err := setBasePolicyDigest(d, base, assurancePolicyAttributeFields, assurancePolicyIdentityFields, assurancePolicyServerFields); err != nil {
+		return err
+	}
+	return mergeBasePolicy(d, base, iap, assurancePolicyAttributeFields, assurancePolicyIdentityFields, assurancePolicyServerFields)
+}
+
+// runtimePolicyBaseCustomizeDiff plans the base policy digest of runtime policies
+func runtimePolicyBaseCustomizeDiff(d *schema.ResourceDiff, c *client.Client) error {
+	return basePolicyCustomizeDiff(d, func(name string) (interface{}, error) {
+		return c.GetRuntimePolicy(name)
+	}, runtimePolicyAttributeFields, runtimePolicyIdentityFields, runtimePolicyServerFields)
+}
+
+// assurancePolicyBaseCustomizeDiff plans the base policy digest of assurance policies of the given type
+func assurancePolicyBaseCustomizeDiff(d *schema.ResourceDiff, c *client.Client, assuranceType string) error {
+	return basePolicyCustomizeDiff(d, func(name string) (interface{}, error) {
+		return c.GetAssurancePolicy(name, assuranceType)
+	}, assurancePolicyAttributeFields, assurancePolicyIdentityFields, assurancePolicyServerFields)
+}
+
+// basePolicyCustomizeDiff plans the digest of the base policy values that the policy keeps. As the diff of the
+// attributes that are not configured is suppressed, a new digest is what updates the policy when the base policy
+// changes or when an attribute overriding it is removed from the configuration.
+func basePolicyCustomizeDiff(d *schema.ResourceDiff, getBase func(name string) (interface{}, error), fields map[string][]string, identityFields, serverFields []string) error {
+	if !d.NewValueKnown("base_policy") || d.Id() == "" || d.HasChange("base_policy") {
+		return d.SetNewComputed("base_policy_digest")
+	}
+	baseName := d.Get("base_policy").(string)
+	if baseName == "" {
+		if d.Get("base_policy_digest").(string) != "" {
+			return d.SetNew("base_policy_digest", "")
+		}
+		return nil
+	}
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+
+	base, err := getBase(baseName)
+	if err != nil {
+		return fmt.Errorf("failed to read base policy %q: %w", baseName, err)
+	}
+	digest, err := basePolicyDigest(base, basePolicyOverrides(rawConfig, fields, identityFields), serverFields)
+	if err != nil {
+		return err
+	}
+	if d.Get("base_policy_digest").(string) != digest {
+		return d.SetNew("base_policy_digest", digest)
+	}
+	return nil
+}
+
+func setBasePolicyDigest(d *schema.ResourceData, base interface{}, fields map[string][]string, identityFields, serverFields []string) error {
+	digest, err := basePolicyDigest(base, basePolicyOverrides(d.GetRawConfig(), fields, identityFields), serverFields)
+	if err != nil {
+		return err
+	}
+	return d.Set("base_policy_digest", digest)
+}
+
+// basePolicyDigest returns the digest of the fields of the base policy that are neither overridden nor managed by
+// the console
+func basePolicyDigest(base interface{}, overridden, serverFields []string) (string, error) {
+	kept, err := policyFields(base)
+	if err != nil {
+		return "", err
+	}
+	for _, key := range append(overridden, serverFields...) {
+		delete(kept, key)
+	}
+	// the keys of maps are marshalled in order
+	data, err := json.Marshal(kept)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// basePolicyOverrides returns the fields of the policy set by the configured attributes, which override the base
+// policy. Attributes match the json fields of the policy by name, fields maps those that are named differently.
+func basePolicyOverrides(rawConfig cty.Value, fields map[string][]string, identityFields []string) []string {
+	overridden := append([]string{}, identityFields...)
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return overridden
+	}
+	for attribute := range rawConfig.Type().AttributeTypes() {
+		if attribute == "base_policy" || !isAttributeConfigured(rawConfig, attribute) {
+			continue
+		}
+		if keys, ok := fields[attribute]; ok {
+			overridden = append(overridden, keys...)
+		} else {
+			overridden = append(overridden, attribute)
+		}
+	}
+	return overridden
+}
+
+// mergeBasePolicy overlays the fields of policy set by the configured attributes on base and stores the result in
+// policy
+func mergeBasePolicy(d *schema.ResourceData, base, policy interface{}, fields map[string][]string, identityFields, serverFields []string) error {
+	return mergeBasePolicyFields(base, policy, basePolicyOverrides(d.GetRawConfig(), fields, identityFields), serverFields)
+}
+
+// mergeBasePolicyFields overlays the overridden fields of policy on base and stores the result in policy
+func mergeBasePolicyFields(base, policy interface{}, overridden, serverFields []string) error {
+	merged, err := policyFields(base)
+	if err != nil {
+		return err
+	}
+	local, err := policyFields(policy)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range overridden {
+		if v, ok := local[key]; ok {
+			merged[key] = v
+		} else {
+			// zero values of omitempty fields are not marshalled
+			delete(merged, key)
+		}
+	}
+	for _, key := range serverFields {
+		delete(merged, key)
+	}
+
+	data, err := json.Marshal(merged)
+	if err != nil {
+		return err
+	}
+	v := reflect.ValueOf(policy).Elem()
+	v.Set(reflect.Zero(v.Type()))
+	return json.Unmarshal(data, policy)
+}
+
+func policyFields(policy interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(policy)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]interface{}
+	err = json.Unmarshal(data, &fields)
+	return fields, err
+}
+
+// isAttributeConfigured reports whether the attribute is set in the configuration, an absent block being an empty list
+func isAttributeConfigured(rawConfig cty.Value, attribute string) bool {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() || !rawConfig.Type().IsObjectType() || !rawConfig.Type().HasAttribute(attribute) {
+		return false
+	}
+	v := rawConfig.GetAttr(attribute)
+	if v.IsNull() {
+		return false
+	}
+	if !v.IsKnown() {
+		return true
+	}
+	if v.Type().IsListType() || v.Type().IsSetType() || v.Type().IsTupleType() {
+		return v.LengthInt() > 0
+	}
+	return true
+}
@@ -0,0 +1,174 @@
+package khulnasoft
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestBasePolicyDigest(t *testing.T) {
+	config := func(enabled cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"name":        cty.StringVal("production"),
+			"base_policy": cty.StringVal("default"),
+			"enabled":     enabled,
+			"description": cty.NullVal(cty.String),
+		})
+	}
+	overriding := config(cty.False)
+	notOverriding := config(cty.NullVal(cty.Bool))
+	base := client.RuntimePolicy{Name: "default", Description: "default policy", Enabled: true}
+
+	digest := func(base client.RuntimePolicy, rawConfig cty.Value) string {
+		t.Helper()
+		digest, err := basePolicyDigest(&base, basePolicyOverrides(rawConfig, runtimePolicyAttributeFields, runtimePolicyIdentityFields), runtimePolicyServerFields)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return digest
+	}
+	overridden := digest(base, overriding)
+
+	// removing the override keeps the value of the base policy, which the digest now covers
+	if digest(base, notOverriding) == overridden {
+		t.Error("expected the digest to change when an override is removed")
+	}
+
+	changed := base
+	changed.Enabled = false
+	if digest(changed, overriding) != overridden {
+		t.Error("expected the digest not to depend on the overridden fields of the base policy")
+	}
+	if digest(changed, notOverriding) == digest(base, notOverriding) {
+		t.Error("expected the digest to change when a kept field of the base policy changes")
+	}
+
+	changed = base
+	changed.Author = "admin"
+	changed.Updated = time.Now()
+	if digest(changed, overriding) != overridden {
+		t.Error("expected the digest not to depend on the fields managed by the console")
+	}
+
+	if overrides := basePolicyOverrides(overriding, runtimePolicyAttributeFields, runtimePolicyIdentityFields); !containsStringEntry(overrides, "enabled") || containsStringEntry(overrides, "description") {
+		t.Errorf("expected enabled only to be overridden, got %v", overrides)
+	}
+}
+
+func TestBasePolicyOverridesMappedAttributes(t *testing.T) {
+	cases := []struct {
+		attribute string
+		value     cty.Value
+		fields    map[string][]string
+		overrides []string
+	}{
+		{attribute: "packages_black_list_file", value: cty.StringVal("denied.csv"), fields: assurancePolicyAttributeFields, overrides: []string{"packages_black_list"}},
+		{attribute: "trusted_base_images_sets", value: cty.ListVal([]cty.Value{cty.StringVal("debian")}), fields: assurancePolicyAttributeFields, overrides: []string{"trusted_base_images"}},
+		{attribute: "scope_conditions", value: cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{"operator": cty.StringVal("and")})}), fields: runtimePolicyAttributeFields, overrides: []string{"scope"}},
+		{attribute: "scope_conditions", value: cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{"operator": cty.StringVal("and")})}), fields: assurancePolicyAttributeFields, overrides: []string{"scope"}},
+		{attribute: "auto_scan_schedule", value: cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{"iteration_type": cty.StringVal("daily")})}), fields: assurancePolicyAttributeFields, overrides: []string{"auto_scan_time"}},
+		{attribute: "blacklisted_licenses_categories", value: cty.ListVal([]cty.Value{cty.StringVal("copyleft")}), fields: assurancePolicyAttributeFields, overrides: []string{"blacklisted_licenses"}},
+		{attribute: "whitelisted_licenses_categories", value: cty.ListVal([]cty.Value{cty.StringVal("permissive")}), fields: assurancePolicyAttributeFields, overrides: []string{"whitelisted_licenses"}},
+		{attribute: "cve_exceptions", value: cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{"cve": cty.StringVal("CVE-2024-3094")})}), fields: assurancePolicyAttributeFields, overrides: []string{"cves_white_list"}},
+		{attribute: "aggregated_vulnerability", value: cty.MapVal(map[string]cty.Value{"enabled": cty.StringVal("true")}), fields: assurancePolicyAttributeFields},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.attribute, func(t *testing.T) {
+			rawConfig := cty.ObjectVal(map[string]cty.Value{
+				"name":        cty.StringVal("production"),
+				"base_policy": cty.StringVal("default"),
+				tc.attribute:  tc.value,
+			})
+			overrides := basePolicyOverrides(rawConfig, tc.fields, nil)
+			if len(overrides) != len(tc.overrides)+1 {
+				t.Errorf("expected %v to be overridden, got %v", tc.overrides, overrides)
+			}
+			for _, field := range tc.overrides {
+				if !containsStringEntry(overrides, field) {
+					t.Errorf("expected %s to be overridden, got %v", field, overrides)
+				}
+			}
+		})
+	}
+}
+
+func TestMergeBasePolicyCveExceptions(t *testing.T) {
+	rawConfig := cty.ObjectVal(map[string]cty.Value{
+		"name":        cty.StringVal("production"),
+		"base_policy": cty.StringVal("default"),
+		"cve_exceptions": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+			"cve": cty.StringVal("CVE-2024-3094"),
+		})}),
+		"cves_white_list": cty.NullVal(cty.List(cty.String)),
+	})
+	base := &client.AssurancePolicy{Name: "default", CvesWhiteListEnabled: true, CvesWhiteList: []string{"CVE-2021-44228"}}
+	// the CVEs of the exceptions are added to cves_white_list at plan time
+	iap := &client.AssurancePolicy{Name: "production", CvesWhiteList: []string{"CVE-2024-3094"}}
+
+	overrides := basePolicyOverrides(rawConfig, assurancePolicyAttributeFields, assurancePolicyIdentityFields)
+	if err := mergeBasePolicyFields(base, iap, overrides, assurancePolicyServerFields); err != nil {
+		t.Fatal(err)
+	}
+	if iap.Name != "production" || !iap.CvesWhiteListEnabled || !equalStringSlices(iap.CvesWhiteList, []string{"CVE-2024-3094"}) {
+		t.Errorf("expected the CVE exceptions to override the CVEs of the base policy, got %+v", iap)
+	}
+}
+
+// TestPolicyAttributeFields checks that every optional attribute of the runtime and assurance policies is named after
+// a json field of the policy or mapped to the fields it sets, so that configuring it overrides the base policy
+func TestPolicyAttributeFields(t *testing.T) {
+	families := []struct {
+		policy    interface{}
+		fields    map[string][]string
+		resources []*schema.Resource
+	}{
+		{
+			policy: client.RuntimePolicy{},
+			fields: runtimePolicyAttributeFields,
+			resources: []*schema.Resource{
+				resourceContainerRuntimePolicy(),
+				resourceFunctionRuntimePolicy(),
+				resourceHostRuntimePolicy(),
+				resourceWindowsHostRuntimePolicy(),
+				resourceKubernetesRuntimePolicy(),
+			},
+		},
+		{
+			policy: client.AssurancePolicy{},
+			fields: assurancePolicyAttributeFields,
+		},
+	}
+	for _, assuranceType := range client.AssuranceTypes {
+		families[1].resources = append(families[1].resources, resourceAssurancePolicy(assuranceType))
+	}
+
+	for _, family := range families {
+		jsonFields := make(map[string]bool)
+		policyType := reflect.TypeOf(family.policy)
+		for i := 0; i < policyType.NumField(); i++ {
+			jsonFields[strings.Split(policyType.Field(i).Tag.Get("json"), ",")[0]] = true
+		}
+		for _, r := range family.resources {
+			for name, attribute := range r.Schema {
+				if !attribute.Optional || name == "base_policy" {
+					continue
+				}
+				keys, ok := family.fields[name]
+				if !ok {
+					keys = []string{name}
+				}
+				for _, key := range keys {
+					if !jsonFields[key] {
+						t.Errorf("%s sets no field of %s", name, policyType.Name())
+					}
+				}
+			}
+		}
+	}
+}
@@ -86,20 +86,28 @@ func Provider(v string) *schema.Provider {
 			"khulnasoft_enforcer_groups":             resourceEnforcerGroup(),
 			"khulnasoft_service":                     resourceService(),
 			"khulnasoft_image":                       resourceImage(),
+			"khulnasoft_image_batch":                 resourceImageBatch(),
 			"khulnasoft_notification_slack":          resourceNotificationOld(),
 			"khulnasoft_container_runtime_policy":    resourceContainerRuntimePolicy(),
 			"khulnasoft_function_runtime_policy":     resourceFunctionRuntimePolicy(),
 			"khulnasoft_host_runtime_policy":         resourceHostRuntimePolicy(),
-			"khulnasoft_host_assurance_policy":       resourceHostAssurancePolicy(),
-			"khulnasoft_vmware_assurance_policy":     resourceVMwareAssurancePolicy(),
-			"khulnasoft_image_assurance_policy":      resourceImageAssurancePolicy(),
-			"khulnasoft_kubernetes_assurance_policy": resourceKubernetesAssurancePolicy(),
-			"khulnasoft_function_assurance_policy":   resourceFunctionAssurancePolicy(),
+			"khulnasoft_windows_host_runtime_policy": resourceWindowsHostRuntimePolicy(),
+			"khulnasoft_kubernetes_runtime_policy":   resourceKubernetesRuntimePolicy(),
+			"khulnasoft_runtime_policy_exception":    resourceRuntimePolicyException(),
+			"khulnasoft_runtime_policy_order":        resourceRuntimePolicyOrder(),
+			"khulnasoft_custom_compliance_script":    resourceCustomComplianceScript(),
+			"khulnasoft_trusted_base_images":         resourceTrustedBaseImages(),
+			"khulnasoft_host_assurance_policy":       resourceAssurancePolicy(client.AssuranceTypeHost),
+			"khulnasoft_vmware_assurance_policy":     resourceAssurancePolicy(client.AssuranceTypeCFApplication),
+			"khulnasoft_image_assurance_policy":      resourceAssurancePolicy(client.AssuranceTypeImage),
+			"khulnasoft_kubernetes_assurance_policy": resourceAssurancePolicy(client.AssuranceTypeKubernetes),
+			"khulnasoft_function_assurance_policy":   resourceAssurancePolicy(client.AssuranceTypeFunction),
 			"khulnasoft_application_scope":           resourceApplicationScope(),
 			"khulnasoft_permissions_sets":            resourcePermissionSet(),
 			//"khulnasoft_sso":						 resourceSSO(),
 			"khulnasoft_role_mapping": resourceRoleMapping(),
 			"khulnasoft_khulnasoft_label":   resourceKhulnasoftLabels(),
+			"khulnasoft_label_assignment":   resourceLabelAssignment(),
 			"khulnasoft_acknowledge":  resourceAcknowledge(),
 			"khulnasoft_notification": resourceSourceNotification(),
 			//saas
@@ -116,13 +124,20 @@ func Provider(v string) *schema.Provider {
 			"khulnasoft_enforcer_groups":             dataSourceEnforcerGroup(),
 			"khulnasoft_service":                     dataSourceService(),
 			"khulnasoft_image":                       dataImage(),
+			"khulnasoft_images":                      dataSourceImages(),
 			"khulnasoft_container_runtime_policy":    dataContainerRuntimePolicy(),
 			"khulnasoft_function_runtime_policy":     dataFunctionRuntimePolicy(),
 			"khulnasoft_host_runtime_policy":         dataHostRuntimePolicy(),
-			"khulnasoft_image_assurance_policy":      dataImageAssurancePolicy(),
-			"khulnasoft_kubernetes_assurance_policy": dataKubernetesAssurancePolicy(),
-			"khulnasoft_host_assurance_policy":       dataHostAssurancePolicy(),
-			"khulnasoft_function_assurance_policy":   dataFunctionAssurancePolicy(),
+			"khulnasoft_runtime_policy_order":        dataRuntimePolicyOrder(),
+			"khulnasoft_effective_runtime_policy":    dataEffectiveRuntimePolicy(),
+			"khulnasoft_image_assurance_policy":      dataAssurancePolicy(client.AssuranceTypeImage),
+			"khulnasoft_kubernetes_assurance_policy": dataAssurancePolicy(client.AssuranceTypeKubernetes),
+			"khulnasoft_host_assurance_policy":       dataAssurancePolicy(client.AssuranceTypeHost),
+			"khulnasoft_function_assurance_policy":   dataAssurancePolicy(client.AssuranceTypeFunction),
+			"khulnasoft_vmware_assurance_policy":     dataAssurancePolicy(client.AssuranceTypeCFApplication),
+			"khulnasoft_assurance_policy_simulation": dataAssurancePolicySimulation(),
+			"khulnasoft_kubernetes_controls":         dataSourceKubernetesControls(),
+			"khulnasoft_licenses":                    dataSourceLicenses(),
 			"khulnasoft_gateways":                    dataSourceGateways(),
 			"khulnasoft_application_scope":           dataApplicationScope(),
 			"khulnasoft_permissions_sets":            dataSourcePermissionsSets(),
@@ -0,0 +1,193 @@
+package khulnasoft
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// assurancePolicyKind describes the assurance policy resource of an assurance type
+type assurancePolicyKind struct {
+	// label names the policies in descriptions, e.g. "image assurance"
+	label       string
+	description string
+}
+
+var assurancePolicyKinds = map[client.AssuranceType]assurancePolicyKind{
+	client.AssuranceTypeImage: {
+		label:       "image assurance",
+		description: "Khulnasoft Image Assurance covers the first part of the container lifecycle: image development. The Image Assurance subsystem detects, assesses, and reports security issues in your images.",
+	},
+	client.AssuranceTypeHost: {
+		label:       "host assurance",
+		description: "Host Assurance is a subsystem of Khulnasoft. It is responsible for:\n Scans host VMs and Kubernetes nodes' file system for security issues, vulnerabilities in OS and programming language packages, open-source licenses, and compliance with CIS benchmarks.\nEvaluates scan findings according to defined Host Assurance Policies.\nDetermines host compliance based on these policies.\nGenerates an audit event for host assurance failure.  ",
+	},
+	client.AssuranceTypeFunction: {
+		label:       "function assurance",
+		description: "Khulnasoft ensures function security for AWS Lambda, Microsoft Azure, and Google Cloud. This includes:\nScanning functions for vulnerabilities and sensitive data. AWS and Azure functions are also checked for excessive permissions.\nEvaluating function risks based on scan results, according to Function Assurance Policies.\nChecking function compliance with these policies.\nFor AWS and Azure, implementing security actions, such as blocking execution of risky functions or failing the CI/CD pipeline.\nProviding comprehensive audits of all security risks, viewable in Khulnasoft Server or a SIEM system.",
+	},
+	client.AssuranceTypeKubernetes: {
+		label:       "kubernetes assurance",
+		description: "Kubernetes Assurance is responsible for checking the security of workload configurations at the pod level, with respect to your organization's security requirements.",
+	},
+	client.AssuranceTypeCFApplication: {
+		label: "VMware assurance",
+	},
+}
+
+func getAssurancePolicyKind(assuranceType client.AssuranceType) assurancePolicyKind {
+	kind, ok := assurancePolicyKinds[assuranceType]
+	if !ok {
+		panic(fmt.Sprintf("unknown assurance type %q", assuranceType))
+	}
+	return kind
+}
+
+// resourceAssurancePolicy returns the assurance policy resource of the given type, declaring the attributes of
+// assurancePolicyFields applying to it
+func resourceAssurancePolicy(assuranceType client.AssuranceType) *schema.Resource {
+	return &schema.Resource{
+		Description:   getAssurancePolicyKind(assuranceType).description,
+		CreateContext: resourceAssurancePolicyCreate(assuranceType),
+		ReadContext:   resourceAssurancePolicyRead(assuranceType),
+		UpdateContext: resourceAssurancePolicyUpdate(assuranceType),
+		DeleteContext: resourceAssurancePolicyDelete(assuranceType),
+		CustomizeDiff: assurancePolicyCustomizeDiff(assuranceType),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: assurancePolicySchema(assuranceType),
+	}
+}
+
+func resourceAssurancePolicyCreate(assuranceType client.AssuranceType) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		ac := m.(*client.Client)
+
+		iap, err := buildAssurancePolicy(d, ac, assuranceType)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := ac.CreateAssurancePolicy(iap, string(assuranceType)); err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId(iap.Name)
+		return resourceAssurancePolicyRead(assuranceType)(ctx, d, m)
+	}
+}
+
+func resourceAssurancePolicyRead(assuranceType client.AssuranceType) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		ac := m.(*client.Client)
+
+		iap, err := ac.GetAssurancePolicy(d.Id(), string(assuranceType))
+		if err != nil {
+			if strings.Contains(fmt.Sprintf("%s", err), "404") {
+				d.SetId("")
+				return nil
+			}
+			return diag.FromErr(err)
+		}
+
+		flattenAssurancePolicy(d, iap, assuranceType)
+		return nil
+	}
+}
+
+func resourceAssurancePolicyUpdate(assuranceType client.AssuranceType) schema.UpdateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		ac := m.(*client.Client)
+
+		if !d.HasChanges(assurancePolicyConfigurableAttributes(assuranceType)...) {
+			return nil
+		}
+
+		iap, err := buildAssurancePolicy(d, ac, assuranceType)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := ac.UpdateAssurancePolicy(iap, string(assuranceType)); err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId(iap.Name)
+		return resourceAssurancePolicyRead(assuranceType)(ctx, d, m)
+	}
+}
+
+func resourceAssurancePolicyDelete(assuranceType client.AssuranceType) schema.DeleteContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		ac := m.(*client.Client)
+
+		if err := ac.DeleteAssurancePolicy(d.Get("name").(string), string(assuranceType)); err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId("")
+		return nil
+	}
+}
+
+// buildAssurancePolicy expands the policy and completes it with its base policy, its custom checks, its package
+// list files and its trusted base image sets
+func buildAssurancePolicy(d *schema.ResourceData, ac *client.Client, assuranceType client.AssuranceType) (*client.AssurancePolicy, error) {
+	iap := expandAssurancePolicy(d, assuranceType)
+	if err := applyBaseAssurancePolicy(d, ac, iap, string(assuranceType)); err != nil {
+		return nil, err
+	}
+	if err := checkCveExceptionsEnabled(d.Get("cve_exceptions").([]interface{}), iap.CvesWhiteListEnabled); err != nil {
+		return nil, err
+	}
+	if err := resolveCustomChecks(d, ac, iap); err != nil {
+		return nil, err
+	}
+	if err := applyPackageListFiles(d, iap); err != nil {
+		return nil, err
+	}
+	if err := applyTrustedBaseImageSets(d, iap); err != nil {
+		return nil, err
+	}
+	return iap, nil
+}
+
+// assurancePolicyConfigurableAttributes returns the attributes of the policies of the given type whose change
+// updates the policy
+func assurancePolicyConfigurableAttributes(assuranceType client.AssuranceType) []string {
+	attributes := []string{"base_policy", "base_policy_digest"}
+	for _, field := range assurancePolicyTypeFields(assuranceType) {
+		if field.schema == nil {
+			continue
+		}
+		if s := field.schema(assuranceType); s.Optional || s.Required {
+			attributes = append(attributes, field.name)
+		}
+	}
+	return attributes
+}
+
+// assurancePolicyCustomizeDiff validates the scope, the auto scan schedule and the package lists of assurance
+// policies, expands the license categories and the CVE exceptions and plans the base policy digest
+func assurancePolicyCustomizeDiff(assuranceType client.AssuranceType) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		if err := assurancePolicyScopeCustomizeDiff(d); err != nil {
+			return err
+		}
+		if err := autoScanScheduleCustomizeDiff(d); err != nil {
+			return err
+		}
+		if err := licenseListsCustomizeDiff(d); err != nil {
+			return err
+		}
+		if err := cveExceptionsCustomizeDiff(d); err != nil {
+			return err
+		}
+		if err := assurancePolicyBaseCustomizeDiff(d, m.(*client.Client), string(assuranceType)); err != nil {
+			return err
+		}
+		return validatePackageListsDiff(d)
+	}
+}
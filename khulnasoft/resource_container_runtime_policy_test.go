@@ -2,6 +2,7 @@ package khulnasoft
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
@@ -176,6 +177,187 @@ func TestResourceKhulnasoftComplexContainerRuntimePolicyCreate(t *testing.T) {
 	})
 }
 
+func TestResourceKhulnasoftContainerRuntimePolicyEnforcementRollout(t *testing.T) {
+	t.Parallel()
+	var runtimePolicy = client.RuntimePolicy{
+		Name:        acctest.RandomWithPrefix("test-container-runtime-policy"),
+		Description: "This is a test description of container runtime policy",
+		Enabled:     true,
+	}
+
+	rootRef := containerRuntimePolicyRef("test")
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: CheckDestroy("khulnasoft_container_runtime_policy.test"),
+		Steps: []resource.TestStep{
+			{
+				Config: getContainerRuntimePolicyResourceEnforcementRollout(runtimePolicy, "2099-01-01T00:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rootRef, "enforcement_stage", "audit"),
+					resource.TestCheckResourceAttr(rootRef, "next_enforcement_stage_at", "2099-01-01T00:00:00Z"),
+				),
+			},
+			{
+				Config: getContainerRuntimePolicyResourceEnforcementRollout(runtimePolicy, "2000-01-01T00:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rootRef, "enforcement_stage", "canary"),
+					resource.TestCheckResourceAttr(rootRef, "next_enforcement_stage_at", "2100-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(rootRef, "enforcement_rollout.0.stage.0.application_scopes.0", "Global"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceKhulnasoftContainerRuntimePolicyBasePolicy(t *testing.T) {
+	t.Parallel()
+	var runtimePolicy = client.RuntimePolicy{
+		Name:        acctest.RandomWithPrefix("test-container-runtime-policy"),
+		Description: "This is a test description of container runtime policy",
+	}
+
+	rootRef := containerRuntimePolicyRef("test")
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: CheckDestroy("khulnasoft_container_runtime_policy.test"),
+		Steps: []resource.TestStep{
+			{
+				Config: getContainerRuntimePolicyResourceBasePolicy(runtimePolicy),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rootRef, "name", runtimePolicy.Name),
+					resource.TestCheckResourceAttr(rootRef, "description", runtimePolicy.Description),
+					resource.TestCheckResourceAttr(rootRef, "block_container_exec", "true"),
+					resource.TestCheckResourceAttr(rootRef, "restricted_volumes.0.volumes.#", "1"),
+					resource.TestCheckResourceAttr(rootRef, "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceKhulnasoftContainerRuntimePolicyValidation(t *testing.T) {
+	t.Parallel()
+	var runtimePolicy = client.RuntimePolicy{
+		Name:        acctest.RandomWithPrefix("test-container-runtime-policy"),
+		Description: "This is a test description of container runtime policy",
+	}
+
+	invalid := []struct {
+		block string
+		error string
+	}{
+		{`linux_capabilities {
+			enabled = true
+			remove_linux_capabilities = ["NET_RAW", "CAP_SYS_ADMINS"]
+		}`, `expected linux_capabilities.0.remove_linux_capabilities.1 to be one of`},
+		{`port_block {
+			enabled = true
+			block_inbound_ports = ["22", "1024-1"]
+		}`, `expected port_block.0.block_inbound_ports.1 to be a port range`},
+		{`blocked_capabilities = ["sys_admins"]`, `expected blocked_capabilities.0 to be one of`},
+		{`blocked_inbound_ports = ["22", "1024-1"]`, `expected blocked_inbound_ports.1 to be a port range`},
+		{`blocked_outbound_ports = ["http"]`, `expected blocked_outbound_ports.0 to be a port between 1 and 65535`},
+		{`malware_scan_options {
+			enabled = true
+			action = "quarantine"
+		}`, `expected malware_scan_options.0.action to be one of`},
+		{`reverse_shell {
+			enabled = true
+			reverse_shell_ip_white_list = ["10.0.0.0/33"]
+		}`, `expected reverse_shell.0.reverse_shell_ip_white_list.0 to be an IP address or a CIDR`},
+		{`tripwire {
+			enabled = true
+			apply_on = ["Env"]
+		}`, `expected tripwire.0.apply_on.0 to be one of`},
+		{`default_security_profile = "runtime_default"`, `expected default_security_profile to be one of`},
+		{`scope_expression = "v1 && (v2 || v3"`, `missing ) closing the ( at position 7`},
+		{`scope_expression = "v1 && v3"
+		scope_variables {
+			attribute = "kubernetes.cluster"
+			value = "prod"
+		}
+		scope_variables {
+			attribute = "kubernetes.namespace"
+			value = "payments"
+		}`, `references v3, but the scope only has 2 variables`},
+		{`scope_conditions {
+			condition {
+				attribute = "kubernetes.label"
+				value = "checkout"
+			}
+		}`, `must set name to the label key`},
+	}
+
+	var steps []resource.TestStep
+	for _, v := range invalid {
+		steps = append(steps, resource.TestStep{
+			Config:      getContainerRuntimePolicyResourceBlock(runtimePolicy, v.block),
+			ExpectError: regexp.MustCompile(regexp.QuoteMeta(v.error)),
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: CheckDestroy("khulnasoft_container_runtime_policy.test"),
+		Steps:        steps,
+	})
+}
+
+func TestResourceKhulnasoftContainerRuntimePolicyScopeConditions(t *testing.T) {
+	t.Parallel()
+	var runtimePolicy = client.RuntimePolicy{
+		Name:        acctest.RandomWithPrefix("test-container-runtime-policy"),
+		Description: "This is a test description of container runtime policy",
+	}
+
+	rootRef := containerRuntimePolicyRef("test")
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: CheckDestroy("khulnasoft_container_runtime_policy.test"),
+		Steps: []resource.TestStep{
+			{
+				Config: getContainerRuntimePolicyResourceBlock(runtimePolicy, `scope_conditions {
+			condition {
+				attribute = "kubernetes.cluster"
+				value = "prod-*"
+			}
+			group {
+				operator = "or"
+				condition {
+					attribute = "kubernetes.namespace"
+					value = "payments"
+				}
+				condition {
+					attribute = "kubernetes.label"
+					name = "app"
+					value = "checkout"
+				}
+			}
+		}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rootRef, "scope_expression", "v1 && (v2 || v3)"),
+					resource.TestCheckResourceAttr(rootRef, "scope_variables.#", "3"),
+					resource.TestCheckResourceAttr(rootRef, "scope_variables.2.attribute", "kubernetes.label"),
+					resource.TestCheckResourceAttr(rootRef, "scope_variables.2.name", "app"),
+					resource.TestCheckResourceAttr(rootRef, "scope_conditions.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func containerRuntimePolicyRef(name string) string {
 	return fmt.Sprintf("khulnasoft_container_runtime_policy.%v", name)
 }
@@ -300,3 +482,58 @@ func getComplexContainerRuntimePolicyResource(policy client.RuntimePolicy) strin
 		policy.EnforceAfterDays,
 		policy.ForkGuardProcessLimit)
 }
+
+func getContainerRuntimePolicyResourceEnforcementRollout(policy client.RuntimePolicy, canaryStartsAt string) string {
+	return fmt.Sprintf(`
+	resource "khulnasoft_container_runtime_policy" "test" {
+		name = "%s"
+		description = "%s"
+		enabled = "%v"
+
+		enforcement_rollout {
+			stage {
+				name               = "canary"
+				starts_at          = "%s"
+				application_scopes = ["Global"]
+			}
+			stage {
+				name      = "all"
+				starts_at = "2100-01-01T00:00:00Z"
+			}
+		}
+	}
+`, policy.Name, policy.Description, policy.Enabled, canaryStartsAt)
+}
+
+func getContainerRuntimePolicyResourceBasePolicy(policy client.RuntimePolicy) string {
+	return fmt.Sprintf(`
+	resource "khulnasoft_container_runtime_policy" "base" {
+		name = "%s-base"
+		enabled = true
+		block_container_exec = true
+
+		restricted_volumes {
+			enabled = true
+			volumes = ["/var/run/docker.sock"]
+		}
+	}
+
+	resource "khulnasoft_container_runtime_policy" "test" {
+		base_policy = khulnasoft_container_runtime_policy.base.name
+		name = "%s"
+		description = "%s"
+		enabled = false
+	}
+`, policy.Name, policy.Name, policy.Description)
+}
+
+func getContainerRuntimePolicyResourceBlock(policy client.RuntimePolicy, block string) string {
+	return fmt.Sprintf(`
+	resource "khulnasoft_container_runtime_policy" "test" {
+		name = "%s"
+		description = "%s"
+
+		%s
+	}
+`, policy.Name, policy.Description, block)
+}
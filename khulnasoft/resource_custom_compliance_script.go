@@ -0,0 +1,260 @@
+package khulnasoft
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// complianceScriptEngines are the interpreters the enforcers run custom compliance scripts with
+var complianceScriptEngines = []string{"bash", "powershell", "yaml"}
+
+var complianceScriptSeverities = []string{"critical", "high", "medium", "low"}
+
+func resourceCustomComplianceScript() *schema.Resource {
+	return &schema.Resource{
+		Description: "The `khulnasoft_custom_compliance_script` resource manages a custom compliance check script. " +
+			"Assurance policies run the script by listing its ID as the `script_id` of one of their `custom_checks`.",
+		CreateContext: resourceCustomComplianceScriptCreate,
+		ReadContext:   resourceCustomComplianceScriptRead,
+		UpdateContext: resourceCustomComplianceScriptUpdate,
+		DeleteContext: resourceCustomComplianceScriptDelete,
+		CustomizeDiff: customComplianceScriptCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"script_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the script, referenced by the `script_id` of the custom checks of assurance policies. It is unknown in the plan of a change of the script, so that the referencing policies are updated with the new script.",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Name of the script.",
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Description of the script.",
+				Optional:    true,
+			},
+			"engine": {
+				Type:         schema.TypeString,
+				Description:  "Interpreter running the script, one of `bash`, `powershell` or `yaml`.",
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(complianceScriptEngines, false),
+			},
+			"severity": {
+				Type:         schema.TypeString,
+				Description:  "Severity of a failure of the check, one of `critical`, `high`, `medium` or `low`.",
+				Optional:     true,
+				Default:      "medium",
+				ValidateFunc: validation.StringInSlice(complianceScriptSeverities, false),
+			},
+			"snippet": {
+				Type:         schema.TypeString,
+				Description:  "Content of the script. Conflicts with `snippet_file`, and set to the content of the file when `snippet_file` is used.",
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"snippet", "snippet_file"},
+			},
+			"snippet_file": {
+				Type:         schema.TypeString,
+				Description:  "Path of the file holding the script, e.g. a file versioned next to the configuration. A change of the content of the file is planned as an update of the script.",
+				Optional:     true,
+				ExactlyOneOf: []string{"snippet", "snippet_file"},
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Description: "Path of the script on the console.",
+				Computed:    true,
+			},
+			"author": {
+				Type:        schema.TypeString,
+				Description: "Name of the user account that created the script.",
+				Computed:    true,
+			},
+			"last_modified": {
+				Type:        schema.TypeInt,
+				Description: "Time of the last modification of the script, in Unix time.",
+				Computed:    true,
+			},
+			"read_only": {
+				Type:        schema.TypeBool,
+				Description: "Indicates if the script is read-only.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// customComplianceScriptCustomizeDiff plans an update of the snippet when the content of snippet_file changed. The
+// script_id of a changed script is planned as unknown, so that the assurance policies referencing the script by
+// script_id are updated with the new script in the same apply.
+func customComplianceScriptCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if file, ok := d.GetOk("snippet_file"); ok && d.NewValueKnown("snippet_file") {
+		snippet, err := readSnippetFile(file.(string))
+		if err != nil {
+			return err
+		}
+		if d.Get("snippet").(string) != snippet {
+			if err := d.SetNew("snippet", snippet); err != nil {
+				return err
+			}
+		}
+	}
+	if d.Id() != "" && d.HasChanges("name", "description", "engine", "severity", "snippet") {
+		return d.SetNewComputed("script_id")
+	}
+	return nil
+}
+
+func readSnippetFile(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed reading snippet_file: %v", err)
+	}
+	return string(content), nil
+}
+
+func resourceCustomComplianceScriptCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	script, err := expandCustomComplianceScript(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := c.CreateComplianceScript(script); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(script.ScriptID)
+
+	return resourceCustomComplianceScriptRead(ctx, d, m)
+}
+
+func resourceCustomComplianceScriptRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	script, err := c.GetComplianceScript(d.Id())
+	if err != nil {
+		if strings.Contains(fmt.Sprintf("%s", err), "404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("script_id", script.ScriptID)
+	d.Set("name", script.Name)
+	d.Set("description", script.Description)
+	d.Set("engine", script.Engine)
+	d.Set("severity", script.Severity)
+	d.Set("snippet", script.Snippet)
+	d.Set("path", script.Path)
+	d.Set("author", script.Author)
+	d.Set("last_modified", script.LastModified)
+	d.Set("read_only", script.ReadOnly)
+
+	return nil
+}
+
+func resourceCustomComplianceScriptUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	if d.HasChanges("name", "description", "engine", "severity", "snippet", "snippet_file") {
+		script, err := expandCustomComplianceScript(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		script.ScriptID = d.Id()
+		if err := c.UpdateComplianceScript(script); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCustomComplianceScriptRead(ctx, d, m)
+}
+
+func resourceCustomComplianceScriptDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	if err := c.DeleteComplianceScript(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func expandCustomComplianceScript(d *schema.ResourceData) (*client.ComplianceScript, error) {
+	script := &client.ComplianceScript{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Engine:      d.Get("engine").(string),
+		Severity:    d.Get("severity").(string),
+		Snippet:     d.Get("snippet").(string),
+	}
+	if file, ok := d.GetOk("snippet_file"); ok {
+		snippet, err := readSnippetFile(file.(string))
+		if err != nil {
+			return nil, err
+		}
+		script.Snippet = snippet
+	}
+	return script, nil
+}
+
+// resolveCustomChecks completes the custom checks of an assurance policy that only reference a custom compliance
+// script by its ID with the script, so that a policy can list a khulnasoft_custom_compliance_script by script_id alone.
+// The script is read again on every create and update, as the other attributes of the check are computed and keep the
+// content of the script at the previous apply.
+func resolveCustomChecks(d *schema.ResourceData, c *client.Client, iap *client.AssurancePolicy) error {
+	for i, check := range iap.CustomChecks {
+		if check.ScriptID == "" || !isCustomCheckReference(d.GetRawConfig(), i, check) {
+			continue
+		}
+		script, err := c.GetComplianceScript(check.ScriptID)
+		if err != nil {
+			return fmt.Errorf("custom_checks: %v", err)
+		}
+		iap.CustomChecks[i] = client.Checks{
+			ScriptID:     script.ScriptID,
+			Name:         script.Name,
+			Path:         script.Path,
+			LastModified: script.LastModified,
+			Description:  script.Description,
+			Engine:       script.Engine,
+			Snippet:      script.Snippet,
+			ReadOnly:     script.ReadOnly,
+			Severity:     script.Severity,
+			Author:       script.Author,
+		}
+	}
+	return nil
+}
+
+// isCustomCheckReference returns whether the custom check i only sets script_id in the configuration. The checks
+// that are not configured, e.g. those of a base policy, are references when they have no snippet.
+func isCustomCheckReference(rawConfig cty.Value, i int, check client.Checks) bool {
+	if !isAttributeConfigured(rawConfig, "custom_checks") {
+		return check.Snippet == ""
+	}
+	checks := rawConfig.GetAttr("custom_checks")
+	if !checks.IsWhollyKnown() || i >= checks.LengthInt() {
+		return check.Snippet == ""
+	}
+	for attribute := range checks.Index(cty.NumberIntVal(int64(i))).Type().AttributeTypes() {
+		if attribute != "script_id" && isAttributeConfigured(checks.Index(cty.NumberIntVal(int64(i))), attribute) {
+			return false
+		}
+	}
+	return true
+}
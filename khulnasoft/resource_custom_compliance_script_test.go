@@ -0,0 +1,135 @@
+package khulnasoft
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestExpandCustomComplianceScriptSnippetFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "check.sh")
+	if err := os.WriteFile(file, []byte("#!/bin/bash\nexit 0\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceCustomComplianceScript().Schema, map[string]interface{}{
+		"name":         "check",
+		"engine":       "bash",
+		"snippet_file": file,
+	})
+	script, err := expandCustomComplianceScript(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if script.Snippet != "#!/bin/bash\nexit 0\n" {
+		t.Errorf("expected the snippet to be read from snippet_file, got %q", script.Snippet)
+	}
+	if script.Severity != "medium" {
+		t.Errorf("expected the default severity medium, got %q", script.Severity)
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceCustomComplianceScript().Schema, map[string]interface{}{
+		"name":         "check",
+		"engine":       "bash",
+		"snippet_file": filepath.Join(t.TempDir(), "missing.sh"),
+	})
+	if _, err := expandCustomComplianceScript(d); err == nil {
+		t.Error("expected an error for a missing snippet_file")
+	}
+}
+
+func TestIsCustomCheckReference(t *testing.T) {
+	check := func(scriptId, snippet cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"script_id": scriptId,
+			"name":      cty.NullVal(cty.String),
+			"snippet":   snippet,
+		})
+	}
+	rawConfig := cty.ObjectVal(map[string]cty.Value{
+		"custom_checks": cty.ListVal([]cty.Value{
+			check(cty.StringVal("12"), cty.NullVal(cty.String)),
+			check(cty.StringVal("13"), cty.StringVal("exit 0")),
+		}),
+	})
+
+	// the snippet of the previous apply does not prevent the script from being read again
+	if !isCustomCheckReference(rawConfig, 0, client.Checks{ScriptID: "12", Snippet: "exit 0"}) {
+		t.Error("expected a check only setting script_id to be a reference")
+	}
+	if isCustomCheckReference(rawConfig, 1, client.Checks{ScriptID: "13", Snippet: "exit 0"}) {
+		t.Error("expected a check setting its snippet not to be a reference")
+	}
+
+	// the checks of a base policy are references unless they embed their script
+	unconfigured := cty.ObjectVal(map[string]cty.Value{
+		"custom_checks": cty.ListValEmpty(check(cty.NullVal(cty.String), cty.NullVal(cty.String)).Type()),
+	})
+	if !isCustomCheckReference(unconfigured, 0, client.Checks{ScriptID: "12"}) || isCustomCheckReference(unconfigured, 0, client.Checks{ScriptID: "12", Snippet: "exit 0"}) {
+		t.Error("expected the checks that are not configured to be references when they have no snippet")
+	}
+}
+
+func TestResourceKhulnasoftCustomComplianceScript(t *testing.T) {
+	t.Parallel()
+	name := acctest.RandomWithPrefix("terraform-test")
+	rootRef := "khulnasoft_custom_compliance_script.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomComplianceScript(name, "exit 0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rootRef, "name", name),
+					resource.TestCheckResourceAttr(rootRef, "engine", "bash"),
+					resource.TestCheckResourceAttr(rootRef, "snippet", "exit 0"),
+					resource.TestCheckResourceAttrSet(rootRef, "script_id"),
+					resource.TestCheckResourceAttrPair("khulnasoft_host_assurance_policy.test", "custom_checks.0.script_id", rootRef, "script_id"),
+					resource.TestCheckResourceAttr("khulnasoft_host_assurance_policy.test", "custom_checks.0.name", name),
+				),
+			},
+			{
+				Config: testAccCustomComplianceScript(name, "exit 1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rootRef, "snippet", "exit 1"),
+					resource.TestCheckResourceAttr("khulnasoft_host_assurance_policy.test", "custom_checks.0.snippet", "exit 1"),
+				),
+			},
+			{
+				ResourceName:      rootRef,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCustomComplianceScript(name, snippet string) string {
+	return fmt.Sprintf(`
+	resource "khulnasoft_custom_compliance_script" "test" {
+		name        = "%s"
+		description = "terraform-test"
+		engine      = "bash"
+		severity    = "high"
+		snippet     = "%s"
+	}
+
+	resource "khulnasoft_host_assurance_policy" "test" {
+		name                  = "%s"
+		application_scopes    = ["Global"]
+		custom_checks_enabled = true
+
+		custom_checks {
+			script_id = khulnasoft_custom_compliance_script.test.script_id
+		}
+	}
+`, name, snippet, name)
+}
@@ -100,6 +100,44 @@ func TestResourceKhulnasoftFunctionRuntimePolicyUpgrade(t *testing.T) {
 	})
 }
 
+func TestResourceKhulnasoftFunctionRuntimePolicySharedControls(t *testing.T) {
+	t.Parallel()
+	var runtimePolicy = client.RuntimePolicy{
+		Name:        acctest.RandomWithPrefix("test-function-runtime-policy"),
+		Description: "This is a test description of function runtime policy",
+		Enabled:     true,
+		Enforce:     false,
+	}
+
+	rootRef := functionRuntimePolicyRef("test")
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: CheckDestroy("khulnasoft_function_runtime_policy.test"),
+		Steps: []resource.TestStep{
+			{
+				// controls shared with the container and host runtime policies
+				Config: getFunctionRuntimePolicyResourceSharedControls(runtimePolicy),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rootRef, "name", runtimePolicy.Name),
+					resource.TestCheckResourceAttr(rootRef, "enforce_after_days", "5"),
+					resource.TestCheckResourceAttr(rootRef, "malware_scan_options.0.enabled", "true"),
+					resource.TestCheckResourceAttr(rootRef, "malware_scan_options.0.action", "alert"),
+					resource.TestCheckResourceAttr(rootRef, "file_integrity_monitoring.0.enabled", "true"),
+					resource.TestCheckResourceAttr(rootRef, "file_integrity_monitoring.0.monitored_files.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "khulnasoft_function_runtime_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func functionRuntimePolicyRef(name string) string {
 	return fmt.Sprintf("khulnasoft_function_runtime_policy.%v", name)
 }
@@ -139,3 +177,28 @@ func getUpdatedFunctionRuntimePolicyResource(policy client.RuntimePolicy) string
 		policy.Enabled,
 		policy.Enforce)
 }
+
+func getFunctionRuntimePolicyResourceSharedControls(policy client.RuntimePolicy) string {
+	return fmt.Sprintf(`
+	resource "khulnasoft_function_runtime_policy" "test" {
+		name = "%s"
+		description = "%s"
+		enabled = "%v"
+		enforce = "%v"
+		enforce_after_days = 5
+		malware_scan_options {
+			enabled = true
+			action = "alert"
+		}
+		file_integrity_monitoring {
+			enabled = true
+			monitored_files = ["/tmp/*"]
+			monitored_files_modify = true
+		}
+	}
+`,
+		policy.Name,
+		policy.Description,
+		policy.Enabled,
+		policy.Enforce)
+}
@@ -2,6 +2,7 @@ package khulnasoft
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
@@ -109,6 +110,15 @@ func TestResourceKhulnasoftComplexHostRuntimePolicyCreate(t *testing.T) {
 	})
 }
 
+func TestResourceKhulnasoftHostRuntimePolicyDeprecatesWindowsControls(t *testing.T) {
+	policySchema := resourceHostRuntimePolicy().Schema
+	for _, name := range windowsOnlyRuntimePolicyControls {
+		if attribute, ok := policySchema[name]; !ok || !strings.Contains(attribute.Deprecated, "khulnasoft_windows_host_runtime_policy") {
+			t.Errorf("expected %s to be deprecated in favour of khulnasoft_windows_host_runtime_policy", name)
+		}
+	}
+}
+
 func hostRuntimePolicyRef(name string) string {
 	return fmt.Sprintf("khulnasoft_host_runtime_policy.%v", name)
 }
@@ -4,11 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceImage() *schema.Resource {
@@ -17,6 +20,7 @@ func resourceImage() *schema.Resource {
 		ReadContext:   resourceImageRead,
 		UpdateContext: resourceImageUpdate,
 		DeleteContext: resourceImageDelete,
+		CustomizeDiff: resourceImageCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -61,6 +65,37 @@ func resourceImage() *schema.Resource {
 				Description: "A comment on why the image was whitelisted or blacklisted",
 				Optional:    true,
 			},
+			"permission_expires_at": {
+				Type:          schema.TypeString,
+				Description:   "An RFC3339 timestamp after which the whitelisting or blacklisting of the image expires. Setting an expiry also applies the permission when the image is created. Once expired, the next plan reports drift and apply reverts the image permission by applying the opposite one.",
+				Optional:      true,
+				ValidateFunc:  validation.IsRFC3339Time,
+				ConflictsWith: []string{"permission_expires_in"},
+				RequiredWith:  []string{"permission_modification_comment"},
+			},
+			"permission_expires_in": {
+				Type:          schema.TypeString,
+				Description:   "A duration (e.g. '72h' or '30d') after which the whitelisting or blacklisting of the image expires, counted from the time the permission is applied. Setting an expiry also applies the permission when the image is created. Once expired, the next plan reports drift and apply reverts the image permission by applying the opposite one.",
+				Optional:      true,
+				ValidateFunc:  validatePermissionExpiresIn,
+				ConflictsWith: []string{"permission_expires_at"},
+				RequiredWith:  []string{"permission_modification_comment"},
+			},
+			"permission_modification_author": {
+				Type:        schema.TypeString,
+				Description: "The author recorded in the permission comment of an expiring permission. Defaults to the user the provider authenticates as, no author being recorded with API key authentication.",
+				Optional:    true,
+			},
+			"permission_expiry": {
+				Type:        schema.TypeString,
+				Description: "The RFC3339 timestamp when the current image permission expires, if an expiry was set.",
+				Computed:    true,
+			},
+			"permission_expired": {
+				Type:        schema.TypeBool,
+				Description: "Whether the expiry of the image permission has passed.",
+				Computed:    true,
+			},
 			"disallowed": {
 				Type:        schema.TypeBool,
 				Description: "Whether the image is disallowed (non-compliant).",
@@ -366,6 +401,64 @@ func resourceImage() *schema.Resource {
 					},
 				},
 			},
+			"assurance_failures": {
+				Type:        schema.TypeList,
+				Description: "The failed image assurance checks, grouped by the Assurance Policy they originated from.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"policy_name": {
+							Type:        schema.TypeString,
+							Description: "The name of the Assurance Policy the failed checks originated from.",
+							Computed:    true,
+						},
+						"assurance_type": {
+							Type:        schema.TypeString,
+							Description: "The type of the Assurance Policy the failed checks originated from.",
+							Computed:    true,
+						},
+						"blocking": {
+							Type:        schema.TypeBool,
+							Description: "Whether at least one of the failed checks of this policy is blocking.",
+							Computed:    true,
+						},
+						"failed_controls": {
+							Type:        schema.TypeList,
+							Description: "The controls of this policy that the image failed.",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"control": {
+										Type:        schema.TypeString,
+										Description: "The name of the image assurance control.",
+										Computed:    true,
+									},
+									"blocking": {
+										Type:        schema.TypeBool,
+										Description: "Whether the control is blocking (i.e. a failure should trigger a disallow).",
+										Computed:    true,
+									},
+									"dta_skipped": {
+										Type:        schema.TypeBool,
+										Description: "If DTA was skipped.",
+										Computed:    true,
+									},
+									"dta_skipped_reason": {
+										Type:        schema.TypeString,
+										Description: "The reason why DTA was skipped.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"assurance_explanation": {
+				Type:        schema.TypeString,
+				Description: "A human-readable explanation of why the image is allowed or disallowed by the Image Assurance Policies.",
+				Computed:    true,
+			},
 			"vulnerabilities": {
 				Type:        schema.TypeList,
 				Description: "A list of all the vulnerabilities found in the image",
@@ -706,6 +799,15 @@ func resourceImageCreate(ctx context.Context, d *schema.ResourceData, m interfac
 	}
 
 	d.SetId(getImageId(image))
+
+	// an expiring permission is applied with the image, the others only on update as before
+	if hasPermissionExpiry(d) {
+		err = changeImagePermission(d, c, image)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceImageRead(ctx, d, m)
 
 }
@@ -713,11 +815,7 @@ func resourceImageCreate(ctx context.Context, d *schema.ResourceData, m interfac
 func resourceImageRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var err error
 	c := m.(*client.Client)
-	id := d.Id()
-	i := strings.LastIndex(id, ":")
-	id = id[:i] + strings.Replace(id[i:], ":", "/", 1)
-
-	newImage, err := c.GetImage(id)
+	newImage, err := c.GetImage(getImageUrl(d.Id()))
 
 	if err != nil {
 		if strings.Contains(fmt.Sprintf("%s", err), "404") {
@@ -776,6 +874,7 @@ func resourceImageRead(ctx context.Context, d *schema.ResourceData, m interface{
 	d.Set("permission_author", newImage.PermissionAuthor)
 	d.Set("permission", newImage.Permission)
 	d.Set("permission_comment", newImage.PermissionComment)
+	d.Set("permission_expired", isPermissionExpired(d.Get("permission_expiry").(string)))
 	d.Set("newer_image_exists", newImage.NewerImageExists)
 	d.Set("partial_results", newImage.PartialResults)
 	d.Set("name", newImage.Name)
@@ -785,6 +884,8 @@ func resourceImageRead(ctx context.Context, d *schema.ResourceData, m interface{
 	d.Set("dta_skipped_reason", newImage.DtaSkippedReason)
 	d.Set("disallowed_by_assurance_checks", newImage.AssuranceResults.Disallowed)
 	d.Set("assurance_checks_performed", flattenAssuranceChecksPerformed(newImage.AssuranceResults.ChecksPerformed))
+	d.Set("assurance_failures", flattenAssuranceFailures(newImage.AssuranceResults.ChecksPerformed))
+	d.Set("assurance_explanation", explainAssuranceResults(newImage))
 	d.Set("history", flattenHistory(newImage.History))
 	d.Set("vulnerabilities", flattenVulnerabilities(vulnerabilities))
 
@@ -798,47 +899,154 @@ func resourceImageUpdate(ctx context.Context, d *schema.ResourceData, m interfac
 	c := m.(*client.Client)
 
 	image := expandImage(d)
-	if d.HasChanges("allow_image", "block_image") {
-		spec, ok := d.GetOk("permission_modification_comment")
-		if !ok {
-			return diag.FromErr(errors.New("permission_modification_comment must be set to allow/block an image"))
+	if d.HasChanges("allow_image", "block_image", "permission_expires_at", "permission_expires_in") {
+		err = changeImagePermission(d, c, image)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else if expiry, planned := d.GetChange("permission_expiry"); isPermissionExpired(expiry.(string)) && planned.(string) == "" {
+		// resourceImageCustomizeDiff planned the revert of the expired whitelisting or blacklisting, which applies
+		// the opposite permission
+		whitelisted, _ := d.GetChange("whitelisted")
+		comment := fmt.Sprintf("Permission expired at %s", expiry.(string))
+		err = c.ChangeImagePermission(image, !whitelisted.(bool), comment)
+		if err != nil {
+			return diag.FromErr(err)
 		}
-		permissionModificationImage := spec.(string)
+		d.Set("permission_expiry", "")
+	}
 
-		var allowImage, blockImage bool
+	d.SetId(getImageId(image))
 
-		spec, ok = d.GetOk("allow_image")
-		if ok {
-			allowImage = spec.(bool)
-		}
+	return resourceImageRead(ctx, d, m)
+}
 
-		spec, ok = d.GetOk("block_image")
-		if ok {
-			blockImage = spec.(bool)
-		}
+// changeImagePermission whitelists or blacklists the image as configured, recording the author and expiry
+// of the permission in its comment when an expiry is set
+func changeImagePermission(d *schema.ResourceData, c *client.Client, image *client.Image) error {
+	allowImage := d.Get("allow_image").(bool)
+	blockImage := d.Get("block_image").(bool)
+	if allowImage && blockImage {
+		return errors.New("both allow_image and block_image can't be true together")
+	}
+	if !allowImage && !blockImage {
+		d.Set("permission_expiry", "")
+		return nil
+	}
 
-		if allowImage && blockImage {
-			return diag.FromErr(errors.New("both allow_image and block_image can't be true together"))
-		}
+	comment, ok := d.GetOk("permission_modification_comment")
+	if !ok {
+		return errors.New("permission_modification_comment must be set to allow/block an image")
+	}
+	permissionModificationComment := comment.(string)
 
-		if allowImage {
-			err = c.ChangeImagePermission(image, true, permissionModificationImage)
-			if err != nil {
-				return diag.FromErr(err)
-			}
+	expiry, err := getPermissionExpiry(d)
+	if err != nil {
+		return err
+	}
+	if expiry != "" {
+		author := d.Get("permission_modification_author").(string)
+		if author == "" {
+			author = c.GetUsername()
+		}
+		if author != "" {
+			permissionModificationComment = fmt.Sprintf("%s (author: %s, expires: %s)", permissionModificationComment, author, expiry)
+		} else {
+			permissionModificationComment = fmt.Sprintf("%s (expires: %s)", permissionModificationComment, expiry)
 		}
+	}
 
-		if blockImage {
-			err = c.ChangeImagePermission(image, false, permissionModificationImage)
-			if err != nil {
-				return diag.FromErr(err)
-			}
+	err = c.ChangeImagePermission(image, allowImage, permissionModificationComment)
+	if err != nil {
+		return err
+	}
+
+	d.Set("permission_expiry", expiry)
+	return nil
+}
+
+// resourceImageCustomizeDiff plans the revert of an expired image permission
+func resourceImageCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" || d.HasChanges("allow_image", "block_image", "permission_expires_at", "permission_expires_in") {
+		return nil
+	}
+	if !isPermissionExpired(d.Get("permission_expiry").(string)) {
+		return nil
+	}
+	if !d.Get("whitelisted").(bool) && !d.Get("blacklisted").(bool) {
+		return nil
+	}
+
+	if err := d.SetNew("permission_expiry", ""); err != nil {
+		return err
+	}
+	if err := d.SetNew("permission_expired", false); err != nil {
+		return err
+	}
+	// the revert applies the opposite permission, whose result is read back from the console
+	if err := d.SetNewComputed("whitelisted"); err != nil {
+		return err
+	}
+	return d.SetNewComputed("blacklisted")
+}
+
+// hasPermissionExpiry reports whether the whitelisting or blacklisting of the image is configured to expire
+func hasPermissionExpiry(d *schema.ResourceData) bool {
+	_, expiresAt := d.GetOk("permission_expires_at")
+	_, expiresIn := d.GetOk("permission_expires_in")
+	return expiresAt || expiresIn
+}
+
+func getPermissionExpiry(d *schema.ResourceData) (string, error) {
+	if v, ok := d.GetOk("permission_expires_at"); ok {
+		expiresAt, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return "", err
+		}
+		return expiresAt.UTC().Format(time.RFC3339), nil
+	}
+	if v, ok := d.GetOk("permission_expires_in"); ok {
+		expiresIn, err := parsePermissionExpiresIn(v.(string))
+		if err != nil {
+			return "", err
 		}
+		return time.Now().Add(expiresIn).UTC().Format(time.RFC3339), nil
+	}
+	return "", nil
+}
+
+func isPermissionExpired(expiry string) bool {
+	if expiry == "" {
+		return false
+	}
+	expiresAt, err := time.Parse(time.RFC3339, expiry)
+	if err != nil {
+		return false
 	}
+	return !time.Now().Before(expiresAt)
+}
 
-	d.SetId(getImageId(image))
+// parsePermissionExpiresIn parses a Go duration, additionally accepting a number of days such as "30d"
+func parsePermissionExpiresIn(v string) (time.Duration, error) {
+	if strings.HasSuffix(v, "d") {
+		days, err := strconv.Atoi(strings.TrimSuffix(v, "d"))
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", v)
+		}
+		return time.Duration(days) * 24 * time.Hour, nil
+	}
+	return time.ParseDuration(v)
+}
 
-	return resourceImageRead(ctx, d, m)
+func validatePermissionExpiresIn(v interface{}, k string) ([]string, []error) {
+	expiresIn, err := parsePermissionExpiresIn(v.(string))
+	if err != nil {
+		return nil, []error{fmt.Errorf("%q must be a duration such as '72h' or '30d': %v", k, err)}
+	}
+	if expiresIn <= 0 {
+		return nil, []error{fmt.Errorf("%q must be a positive duration, got %q", k, v)}
+	}
+	return nil, nil
 }
 
 func resourceImageDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -874,6 +1082,15 @@ func getImageId(image *client.Image) string {
 	return fmt.Sprintf("%v/%v:%v", image.Registry, image.Repository, image.Tag)
 }
 
+// getImageUrl converts a registry/repository:tag image ID to the registry/repository/tag API path
+func getImageUrl(id string) string {
+	i := strings.LastIndex(id, ":")
+	if i < 0 {
+		return id
+	}
+	return id[:i] + strings.Replace(id[i:], ":", "/", 1)
+}
+
 func flattenHistory(histories []client.History) []map[string]interface{} {
 	specs := make([]map[string]interface{}, len(histories))
 	for i := range histories {
@@ -907,6 +1124,105 @@ func flattenAssuranceChecksPerformed(checksPerformed []client.ChecksPerformed) [
 	return specs
 }
 
+// flattenAssuranceFailures groups the failed assurance checks by the policy they originated from,
+// keeping the order in which the policies were evaluated.
+func flattenAssuranceFailures(checksPerformed []client.ChecksPerformed) []map[string]interface{} {
+	specs := make([]map[string]interface{}, 0)
+	policies := make(map[string]map[string]interface{})
+
+	for i := range checksPerformed {
+		check := checksPerformed[i]
+		if !check.Failed {
+			continue
+		}
+
+		policy, ok := policies[check.PolicyName]
+		if !ok {
+			policy = map[string]interface{}{
+				"policy_name":     check.PolicyName,
+				"assurance_type":  check.AssuranceType,
+				"blocking":        false,
+				"failed_controls": make([]map[string]interface{}, 0),
+			}
+			policies[check.PolicyName] = policy
+			specs = append(specs, policy)
+		}
+
+		if check.Blocking {
+			policy["blocking"] = true
+		}
+		policy["failed_controls"] = append(policy["failed_controls"].([]map[string]interface{}), map[string]interface{}{
+			"control":            check.Control,
+			"blocking":           check.Blocking,
+			"dta_skipped":        check.DtaSkipped,
+			"dta_skipped_reason": check.DtaSkippedReason,
+		})
+	}
+
+	return specs
+}
+
+// explainAssuranceResults describes in plain text why an image is allowed or disallowed
+func explainAssuranceResults(image *client.Image) string {
+	var failed, blocking int
+	var lines []string
+
+	for _, check := range image.AssuranceResults.ChecksPerformed {
+		if !check.Failed {
+			continue
+		}
+		failed++
+
+		kind := "non-blocking"
+		if check.Blocking {
+			kind = "blocking"
+			blocking++
+		}
+		line := fmt.Sprintf("- policy %q: control %q failed (%s)", check.PolicyName, check.Control, kind)
+		if check.DtaSkipped {
+			line = line + fmt.Sprintf(", DTA skipped: %s", check.DtaSkippedReason)
+		}
+		lines = append(lines, line)
+	}
+
+	var summary string
+	switch {
+	case image.Blacklisted:
+		summary = "Image is blocked manually"
+		if image.PermissionComment != "" {
+			summary = summary + fmt.Sprintf(": %s", image.PermissionComment)
+		}
+		summary = summary + "."
+	case image.Whitelisted:
+		summary = "Image is allowed manually"
+		if image.PermissionComment != "" {
+			summary = summary + fmt.Sprintf(": %s", image.PermissionComment)
+		}
+		summary = summary + "."
+	case image.AssuranceResults.Disallowed:
+		summary = fmt.Sprintf("Image is disallowed by %d blocking of %d failed assurance checks.", blocking, failed)
+	case image.PendingDisallowed:
+		summary = fmt.Sprintf("Image is pending disallow by %d blocking of %d failed assurance checks.", blocking, failed)
+	case failed > 0:
+		summary = fmt.Sprintf("Image is allowed although %d assurance checks failed.", failed)
+	default:
+		summary = fmt.Sprintf("Image passed all %d assurance checks.", len(image.AssuranceResults.ChecksPerformed))
+	}
+
+	if image.DtaSkipped {
+		summary = summary + " DTA was skipped"
+		if image.DtaSkippedReason != "" {
+			summary = summary + fmt.Sprintf(": %s", image.DtaSkippedReason)
+		}
+		summary = summary + "."
+	}
+
+	if len(lines) == 0 {
+		return summary
+	}
+	return summary + "\n" + strings.Join(lines, "\n")
+}
+
 func flattenVulnerabilities(vulnerabilities []client.Vulnerabilities) []map[string]interface{} {
 	specs := make([]map[string]interface{}, len(vulnerabilities))
 
@@ -34,6 +34,28 @@ func TestKhulnasoftImageAssurancePolicy(t *testing.T) {
 	})
 }
 
+func TestKhulnasoftImageAssurancePolicyBasePolicy(t *testing.T) {
+	t.Parallel()
+	name := acctest.RandomWithPrefix("terraform-test")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: CheckDestroy("khulnasoft_image_assurance_policy.terraformiap"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckImageAssurancePolicyBasePolicy(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageAssurancePolicyExists("khulnasoft_image_assurance_policy.terraformiap"),
+					resource.TestCheckResourceAttr("khulnasoft_image_assurance_policy.terraformiap", "description", "Cloned using Terraform"),
+					resource.TestCheckResourceAttr("khulnasoft_image_assurance_policy.terraformiap", "cvss_severity_enabled", "true"),
+					resource.TestCheckResourceAttr("khulnasoft_image_assurance_policy.terraformiap", "cvss_severity", "critical"),
+					resource.TestCheckResourceAttr("khulnasoft_image_assurance_policy.terraformiap", "only_none_root_users", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckImageAssurancePolicy(description string, name string, application_scopes string) string {
 	return fmt.Sprintf(`
 	resource "khulnasoft_image_assurance_policy" "terraformiap" {
@@ -46,6 +68,28 @@ func testAccCheckImageAssurancePolicy(description string, name string, applicati
 
 }
 
+func testAccCheckImageAssurancePolicyBasePolicy(name string) string {
+	return fmt.Sprintf(`
+	resource "khulnasoft_image_assurance_policy" "base" {
+		description = "Created using Terraform"
+		name = "%s-base"
+		application_scopes = [
+			"Global"
+		]
+		cvss_severity_enabled = true
+		cvss_severity = "critical"
+		only_none_root_users = true
+	}
+
+	resource "khulnasoft_image_assurance_policy" "terraformiap" {
+		base_policy = khulnasoft_image_assurance_policy.base.name
+		description = "Cloned using Terraform"
+		name = "%s"
+		only_none_root_users = false
+	}`, name, name)
+
+}
+
 func testAccCheckImageAssurancePolicyExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
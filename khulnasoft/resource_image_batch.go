@@ -0,0 +1,278 @@
+package khulnasoft
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceImageBatch() *schema.Resource {
+	return &schema.Resource{
+		Description: "The `khulnasoft_image_batch` resource registers a set of images in a single request and tracks the scan status of each of them. " +
+			"Use it instead of many `khulnasoft_image` resources when registering all the tags of a repository set.",
+		CreateContext: resourceImageBatchCreate,
+		ReadContext:   resourceImageBatchRead,
+		UpdateContext: resourceImageBatchUpdate,
+		DeleteContext: resourceImageBatchDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"image": {
+				Type:        schema.TypeSet,
+				Description: "An image to register.",
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"registry": {
+							Type:        schema.TypeString,
+							Description: "The name of the registry where the image is stored.",
+							Required:    true,
+						},
+						"repository": {
+							Type:        schema.TypeString,
+							Description: "The name of the image's repository.",
+							Required:    true,
+						},
+						"tag": {
+							Type:        schema.TypeString,
+							Description: "The tag of the image.",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"wait_for_scan": {
+				Type:        schema.TypeBool,
+				Description: "If true, create and update wait until every registered image has finished scanning. Defaults to true. The wait is bounded by the create and update timeouts.",
+				Optional:    true,
+				Default:     true,
+			},
+			"scan_statuses": {
+				Type:        schema.TypeMap,
+				Description: "The scan status of each image (either 'pending', 'in_progress', 'finished', 'failed' or 'not_started'), keyed by the `registry/repository:tag` image ID.",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"results": {
+				Type:        schema.TypeList,
+				Description: "The scan results of each registered image, ordered by image ID.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Description: "The `registry/repository:tag` ID of the image.",
+							Computed:    true,
+						},
+						"digest": {
+							Type:        schema.TypeString,
+							Description: "The content digest of the image.",
+							Computed:    true,
+						},
+						"scan_status": {
+							Type:        schema.TypeString,
+							Description: "The scan status of the image (either 'pending', 'in_progress', 'finished', 'failed' or 'not_started').",
+							Computed:    true,
+						},
+						"scan_date": {
+							Type:        schema.TypeString,
+							Description: "The date and time when the image was last scanned.",
+							Computed:    true,
+						},
+						"scan_error": {
+							Type:        schema.TypeString,
+							Description: "If the image scan failed, the failure message.",
+							Computed:    true,
+						},
+						"disallowed": {
+							Type:        schema.TypeBool,
+							Description: "Whether the image is disallowed (non-compliant).",
+							Computed:    true,
+						},
+						"critical_vulnerabilities": {
+							Type:        schema.TypeInt,
+							Description: "Number of critical severity vulnerabilities detected in the image.",
+							Computed:    true,
+						},
+						"high_vulnerabilities": {
+							Type:        schema.TypeInt,
+							Description: "Number of high severity vulnerabilities detected in the image.",
+							Computed:    true,
+						},
+						"medium_vulnerabilities": {
+							Type:        schema.TypeInt,
+							Description: "Number of medium severity vulnerabilities detected in the image.",
+							Computed:    true,
+						},
+						"low_vulnerabilities": {
+							Type:        schema.TypeInt,
+							Description: "Number of low severity vulnerabilities detected in the image.",
+							Computed:    true,
+						},
+						"total_vulnerabilities": {
+							Type:        schema.TypeInt,
+							Description: "The total number of vulnerabilities detected in the image.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceImageBatchCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+	images := expandImageBatch(d.Get("image").(*schema.Set))
+
+	err := c.CreateImages(images)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resource.UniqueId())
+
+	if d.Get("wait_for_scan").(bool) {
+		waitCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+		defer cancel()
+		err = c.WaitUntilScansCompleted(waitCtx, images)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceImageBatchRead(ctx, d, m)
+}
+
+func resourceImageBatchRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG]  inside resourceImageBatchRead")
+	c := m.(*client.Client)
+	images := expandImageBatch(d.Get("image").(*schema.Set))
+
+	var found []interface{}
+	var results []map[string]interface{}
+	scanStatuses := make(map[string]interface{})
+	for i := range images {
+		image, err := c.GetImage(fmt.Sprintf("%v/%v/%v", images[i].Registry, images[i].Repository, images[i].Tag))
+		if err != nil {
+			if strings.Contains(fmt.Sprintf("%s", err), "404") {
+				log.Printf("[WARN] image %s of batch %s no longer exists", getImageId(&images[i]), d.Id())
+				continue
+			}
+			return diag.FromErr(err)
+		}
+
+		imageId := getImageId(&images[i])
+		found = append(found, map[string]interface{}{
+			"registry":   images[i].Registry,
+			"repository": images[i].Repository,
+			"tag":        images[i].Tag,
+		})
+		scanStatuses[imageId] = image.ScanStatus
+		results = append(results, map[string]interface{}{
+			"id":                       imageId,
+			"digest":                   image.Digest,
+			"scan_status":              image.ScanStatus,
+			"scan_date":                image.ScanDate,
+			"scan_error":               image.ScanError,
+			"disallowed":               image.Disallowed,
+			"critical_vulnerabilities": image.CritVulns,
+			"high_vulnerabilities":     image.HighVulns,
+			"medium_vulnerabilities":   image.MedVulns,
+			"low_vulnerabilities":      image.LowVulns,
+			"total_vulnerabilities":    image.VulnsFound,
+		})
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return results[i]["id"].(string) < results[j]["id"].(string)
+	})
+
+	if len(found) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("image", found); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("scan_statuses", scanStatuses)
+	d.Set("results", results)
+
+	return nil
+}
+
+func resourceImageBatchUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	if d.HasChange("image") {
+		o, n := d.GetChange("image")
+		removed := expandImageBatch(o.(*schema.Set).Difference(n.(*schema.Set)))
+		added := expandImageBatch(n.(*schema.Set).Difference(o.(*schema.Set)))
+
+		for i := range removed {
+			err := c.DeleteImage(&removed[i])
+			if err != nil && !strings.Contains(fmt.Sprintf("%s", err), "404") {
+				return diag.FromErr(err)
+			}
+		}
+
+		if len(added) > 0 {
+			err := c.CreateImages(added)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if d.Get("wait_for_scan").(bool) {
+				waitCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
+				defer cancel()
+				err = c.WaitUntilScansCompleted(waitCtx, added)
+				if err != nil {
+					return diag.FromErr(err)
+				}
+			}
+		}
+	}
+
+	return resourceImageBatchRead(ctx, d, m)
+}
+
+func resourceImageBatchDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+	images := expandImageBatch(d.Get("image").(*schema.Set))
+
+	for i := range images {
+		err := c.DeleteImage(&images[i])
+		if err != nil && !strings.Contains(fmt.Sprintf("%s", err), "404") {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandImageBatch(set *schema.Set) []client.Image {
+	images := make([]client.Image, 0, set.Len())
+	for _, v := range set.List() {
+		image := v.(map[string]interface{})
+		images = append(images, client.Image{
+			Registry:   image["registry"].(string),
+			Repository: image["repository"].(string),
+			Tag:        image["tag"].(string),
+		})
+	}
+	return images
+}
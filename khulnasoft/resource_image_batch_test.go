@@ -0,0 +1,59 @@
+package khulnasoft
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestResourceKhulnasoftImageBatch(t *testing.T) {
+	t.Parallel()
+	registry := acctest.RandomWithPrefix("terraform-test")
+	first := client.Image{Registry: registry, Repository: "alpine", Tag: "3.13"}
+	second := client.Image{Registry: registry, Repository: "alpine", Tag: "3.14"}
+	rootRef := "khulnasoft_image_batch.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: CheckDestroy(rootRef),
+		Steps: []resource.TestStep{
+			{
+				Config: getImageBatchResource(registry, first),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rootRef, "image.#", "1"),
+					resource.TestCheckResourceAttr(rootRef, "results.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(rootRef, "results.*", map[string]string{"id": getImageId(&first)}),
+					resource.TestCheckResourceAttrSet(rootRef, fmt.Sprintf("scan_statuses.%s", getImageId(&first))),
+				),
+			},
+			{
+				Config: getImageBatchResource(registry, first, second),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rootRef, "image.#", "2"),
+					resource.TestCheckResourceAttr(rootRef, "results.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(rootRef, "results.*", map[string]string{"id": getImageId(&second)}),
+					resource.TestCheckResourceAttrSet(rootRef, fmt.Sprintf("scan_statuses.%s", getImageId(&second))),
+				),
+			},
+		},
+	})
+}
+
+func getImageBatchResource(registry string, images ...client.Image) string {
+	blocks := ""
+	for _, image := range images {
+		blocks = blocks + fmt.Sprintf(`
+		image {
+			registry = khulnasoft_integration_registry.demo.id
+			repository = "%s"
+			tag = "%s"
+		}`, image.Repository, image.Tag)
+	}
+	return getRegistry(registry) + fmt.Sprintf(`
+	resource "khulnasoft_image_batch" "test" {%s
+	}
+`, blocks)
+}
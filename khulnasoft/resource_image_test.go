@@ -3,6 +3,9 @@ package khulnasoft
 import (
 	"fmt"
 	"os"
+	"reflect"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
@@ -48,6 +51,8 @@ func TestResourceKhulnasoftImageCreate(t *testing.T) {
 					resource.TestCheckResourceAttrSet(rootRef, "total_vulnerabilities"),
 					resource.TestCheckResourceAttr(rootRef, "author", os.Getenv("KHULNASOFT_USER")),
 					resource.TestCheckResourceAttrSet(rootRef, "image_size"),
+					resource.TestCheckResourceAttrSet(rootRef, "assurance_explanation"),
+					resource.TestCheckResourceAttrSet(rootRef, "assurance_failures.#"),
 				),
 			},
 			{
@@ -91,6 +96,92 @@ func TestResourceKhulnasoftImageAllow(t *testing.T) {
 	})
 }
 
+func TestExplainAssuranceResults(t *testing.T) {
+	checks := []client.ChecksPerformed{
+		{PolicyName: "Default", AssuranceType: "image", Control: "max_severity", Failed: true, Blocking: true},
+		{PolicyName: "Default", AssuranceType: "image", Control: "malware"},
+		{PolicyName: "Licenses", AssuranceType: "image", Control: "license", Failed: true, DtaSkipped: true, DtaSkippedReason: "not licensed"},
+	}
+	failures := []string{
+		`- policy "Default": control "max_severity" failed (blocking)`,
+		`- policy "Licenses": control "license" failed (non-blocking), DTA skipped: not licensed`,
+	}
+	cases := []struct {
+		name        string
+		image       client.Image
+		explanation []string
+	}{
+		{
+			name:        "passed",
+			image:       client.Image{AssuranceResults: client.AssuranceResults{ChecksPerformed: checks[1:2]}},
+			explanation: []string{"Image passed all 1 assurance checks."},
+		},
+		{
+			name:        "disallowed",
+			image:       client.Image{AssuranceResults: client.AssuranceResults{Disallowed: true, ChecksPerformed: checks}},
+			explanation: append([]string{"Image is disallowed by 1 blocking of 2 failed assurance checks."}, failures...),
+		},
+		{
+			name:        "pending disallowed",
+			image:       client.Image{PendingDisallowed: true, AssuranceResults: client.AssuranceResults{ChecksPerformed: checks}},
+			explanation: append([]string{"Image is pending disallow by 1 blocking of 2 failed assurance checks."}, failures...),
+		},
+		{
+			name:        "allowed with failures",
+			image:       client.Image{AssuranceResults: client.AssuranceResults{ChecksPerformed: checks[1:]}},
+			explanation: []string{"Image is allowed although 1 assurance checks failed.", failures[1]},
+		},
+		{
+			name:        "blocked manually",
+			image:       client.Image{Blacklisted: true, PermissionComment: "compromised", AssuranceResults: client.AssuranceResults{ChecksPerformed: checks[1:2]}},
+			explanation: []string{"Image is blocked manually: compromised."},
+		},
+		{
+			name:        "allowed manually",
+			image:       client.Image{Whitelisted: true, AssuranceResults: client.AssuranceResults{Disallowed: true, ChecksPerformed: checks[:1]}},
+			explanation: []string{"Image is allowed manually.", failures[0]},
+		},
+		{
+			name:        "dta skipped",
+			image:       client.Image{DtaSkipped: true, DtaSkippedReason: "no license"},
+			explanation: []string{"Image passed all 0 assurance checks. DTA was skipped: no license."},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if explanation := explainAssuranceResults(&tc.image); explanation != strings.Join(tc.explanation, "\n") {
+				t.Errorf("expected %q, got %q", strings.Join(tc.explanation, "\n"), explanation)
+			}
+		})
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"policy_name":    "Default",
+			"assurance_type": "image",
+			"blocking":       true,
+			"failed_controls": []map[string]interface{}{
+				{"control": "max_severity", "blocking": true, "dta_skipped": false, "dta_skipped_reason": ""},
+			},
+		},
+		{
+			"policy_name":    "Licenses",
+			"assurance_type": "image",
+			"blocking":       false,
+			"failed_controls": []map[string]interface{}{
+				{"control": "license", "blocking": false, "dta_skipped": true, "dta_skipped_reason": "not licensed"},
+			},
+		},
+	}
+	if failures := flattenAssuranceFailures(checks); !reflect.DeepEqual(failures, expected) {
+		t.Errorf("expected %v, got %v", expected, failures)
+	}
+	if failures := flattenAssuranceFailures(checks[1:2]); len(failures) != 0 {
+		t.Errorf("expected no failures, got %v", failures)
+	}
+}
+
 func TestResourceKhulnasoftImageBlock(t *testing.T) {
 	//t.Parallel()
 	image := newTestImage()
@@ -116,6 +207,7 @@ func TestResourceKhulnasoftImageBlock(t *testing.T) {
 					resource.TestCheckResourceAttr(rootRef, "blacklisted", "true"),
 					resource.TestCheckResourceAttr(rootRef, "whitelisted", "false"),
 					resource.TestCheckResourceAttr(rootRef, "permission_comment", "This image is blacklisted from terraform test."),
+					resource.TestMatchResourceAttr(rootRef, "assurance_explanation", regexp.MustCompile(`(?m)^Image is blocked manually: This image is blacklisted from terraform test\.$`)),
 				),
 			},
 		},
@@ -161,6 +253,39 @@ func TestResourceKhulnasoftImageAllowAndBlock(t *testing.T) {
 	})
 }
 
+func TestResourceKhulnasoftImageAllowExpiring(t *testing.T) {
+	//t.Parallel()
+	image := newTestImage()
+	rootRef := imageResourceRef("test")
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: CheckDestroy("khulnasoft_image.test"),
+		Steps: []resource.TestStep{
+			{
+				Config: getImageResourceAllowExpiring(&image, "This image is whitelisted from terraform test.", "2099-01-01T00:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rootRef, "whitelisted", "true"),
+					resource.TestCheckResourceAttr(rootRef, "permission_expiry", "2099-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(rootRef, "permission_expired", "false"),
+					resource.TestCheckResourceAttr(rootRef, "permission_comment", "This image is whitelisted from terraform test. (author: terraform, expires: 2099-01-01T00:00:00Z)"),
+				),
+			},
+			{
+				// the permission expires as soon as it is applied, so the refresh after apply plans its revert
+				Config: getImageResourceAllowExpiring(&image, "This image is whitelisted from terraform test.", "2000-01-01T00:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rootRef, "permission_expiry", "2000-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(rootRef, "permission_expired", "true"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func imageResourceRef(name string) string {
 	return fmt.Sprintf("khulnasoft_image.%s", name)
 }
@@ -199,6 +324,20 @@ func getImageResourceBlock(image *client.Image, comment string) string {
 `, image.Repository, image.Tag, comment)
 }
 
+func getImageResourceAllowExpiring(image *client.Image, comment, expiresAt string) string {
+	return getRegistry(image.Registry) + fmt.Sprintf(`
+	resource "khulnasoft_image" "test" {
+		registry = khulnasoft_integration_registry.demo.id
+		repository = "%s"
+		tag = "%s"
+		allow_image = true
+		permission_modification_comment = "%s"
+		permission_modification_author = "terraform"
+		permission_expires_at = "%s"
+	}
+`, image.Repository, image.Tag, comment, expiresAt)
+}
+
 func getRegistry(name string) string {
 	return fmt.Sprintf(`
 	resource "khulnasoft_integration_registry" "demo" {
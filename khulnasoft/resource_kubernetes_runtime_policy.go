@@ -0,0 +1,106 @@
+package khulnasoft
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// kubernetesRuntimePolicyControls are the runtime policy controls supported by khulnasoft_kubernetes_runtime_policy,
+// the container controls without the Windows only ones
+var kubernetesRuntimePolicyControls = runtimePolicyPlatformControls("linux", containerRuntimePolicyControls)
+
+func resourceKubernetesRuntimePolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKubernetesRuntimePolicyCreate,
+		ReadContext:   resourceKubernetesRuntimePolicyRead,
+		UpdateContext: resourceKubernetesRuntimePolicyUpdate,
+		DeleteContext: resourceKubernetesRuntimePolicyDelete,
+		CustomizeDiff: runtimePolicyCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: runtimePolicySchema("kubernetes", kubernetesRuntimePolicyControls, map[string]*schema.Schema{}),
+	}
+}
+
+func resourceKubernetesRuntimePolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+	name := d.Get("name").(string)
+
+	crp := expandKubernetesRuntimePolicy(d)
+	if err := applyBaseRuntimePolicy(d, c, crp); err != nil {
+		return diag.FromErr(err)
+	}
+	err := c.CreateRuntimePolicy(crp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(name)
+	return resourceKubernetesRuntimePolicyRead(ctx, d, m)
+}
+
+func resourceKubernetesRuntimePolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	crp, err := c.GetRuntimePolicy(d.Id())
+	if err != nil {
+		if strings.Contains(fmt.Sprintf("%s", err), "404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	flattenKubernetesRuntimePolicy(d, crp)
+	d.SetId(crp.Name)
+
+	return nil
+}
+
+func resourceKubernetesRuntimePolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+	name := d.Get("name").(string)
+
+	if d.HasChangesExcept("name") {
+		crp := expandKubernetesRuntimePolicy(d)
+		if err := applyBaseRuntimePolicy(d, c, crp); err != nil {
+			return diag.FromErr(err)
+		}
+		err := c.UpdateRuntimePolicy(crp)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId(name)
+	}
+
+	return resourceKubernetesRuntimePolicyRead(ctx, d, m)
+}
+
+func resourceKubernetesRuntimePolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+	name := d.Get("name").(string)
+
+	err := c.DeleteRuntimePolicy(name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandKubernetesRuntimePolicy(d *schema.ResourceData) *client.RuntimePolicy {
+	crp := expandRuntimePolicy(d, "kubernetes")
+	expandRuntimePolicyControls(d, crp, kubernetesRuntimePolicyControls)
+	return crp
+}
+
+func flattenKubernetesRuntimePolicy(d *schema.ResourceData, crp *client.RuntimePolicy) {
+	flattenRuntimePolicy(d, crp, kubernetesRuntimePolicyControls)
+}
@@ -0,0 +1,108 @@
+package khulnasoft
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestResourceKhulnasoftKubernetesRuntimePolicyCreate(t *testing.T) {
+	t.Parallel()
+	var runtimePolicy = client.RuntimePolicy{
+		Name:        acctest.RandomWithPrefix("test-kubernetes-runtime-policy"),
+		Description: "This is a test description of kubernetes runtime policy",
+		Enabled:     true,
+		Enforce:     false,
+	}
+
+	rootRef := kubernetesRuntimePolicyRef("test")
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: CheckDestroy("khulnasoft_kubernetes_runtime_policy.test"),
+		Steps: []resource.TestStep{
+			{
+				Config: getKubernetesRuntimePolicyResource(runtimePolicy),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rootRef, "name", runtimePolicy.Name),
+					resource.TestCheckResourceAttr(rootRef, "description", runtimePolicy.Description),
+					resource.TestCheckResourceAttr(rootRef, "enabled", fmt.Sprintf("%v", runtimePolicy.Enabled)),
+					resource.TestCheckResourceAttr(rootRef, "enforce", fmt.Sprintf("%v", runtimePolicy.Enforce)),
+					resource.TestCheckResourceAttr(rootRef, "runtime_type", "kubernetes"),
+					resource.TestCheckResourceAttr(rootRef, "block_non_k8s_containers", "true"),
+					resource.TestCheckResourceAttr(rootRef, "failed_kubernetes_checks.0.enabled", "true"),
+					resource.TestCheckResourceAttr(rootRef, "failed_kubernetes_checks.0.failed_checks.#", "1"),
+					resource.TestCheckResourceAttr(rootRef, "linux_capabilities.0.remove_linux_capabilities.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "khulnasoft_kubernetes_runtime_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceKhulnasoftKubernetesRuntimePolicyRejectsWindowsControls(t *testing.T) {
+	t.Parallel()
+	var runtimePolicy = client.RuntimePolicy{
+		Name:        acctest.RandomWithPrefix("test-kubernetes-runtime-policy"),
+		Description: "This is a test description of kubernetes runtime policy",
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      getKubernetesRuntimePolicyResourceWindowsControls(runtimePolicy),
+				ExpectError: regexp.MustCompile(`An argument named "readonly_registry" is not expected here`),
+			},
+		},
+	})
+}
+
+func kubernetesRuntimePolicyRef(name string) string {
+	return fmt.Sprintf("khulnasoft_kubernetes_runtime_policy.%v", name)
+}
+
+func getKubernetesRuntimePolicyResource(policy client.RuntimePolicy) string {
+	return fmt.Sprintf(`
+	resource "khulnasoft_kubernetes_runtime_policy" "test" {
+		name = "%s"
+		description = "%s"
+		enabled = "%v"
+		enforce = "%v"
+		block_non_k8s_containers = true
+
+		failed_kubernetes_checks {
+			enabled       = true
+			failed_checks = ["KSV001"]
+		}
+		linux_capabilities {
+			enabled                   = true
+			remove_linux_capabilities = ["NET_RAW"]
+		}
+	}
+`, policy.Name, policy.Description, policy.Enabled, policy.Enforce)
+}
+
+func getKubernetesRuntimePolicyResourceWindowsControls(policy client.RuntimePolicy) string {
+	return fmt.Sprintf(`
+	resource "khulnasoft_kubernetes_runtime_policy" "test" {
+		name = "%s"
+		description = "%s"
+
+		readonly_registry {
+			enabled                 = true
+			readonly_registry_paths = ["HKEY_LOCAL_MACHINE\\SYSTEM"]
+		}
+	}
+`, policy.Name, policy.Description)
+}
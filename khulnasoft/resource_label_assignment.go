@@ -0,0 +1,145 @@
+package khulnasoft
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceLabelAssignment() *schema.Resource {
+	return &schema.Resource{
+		Description: "The `khulnasoft_label_assignment` resource attaches a Khulnasoft label to an image or to another supported object, " +
+			"so that Assurance Policies scoped on labels can be driven from code.",
+		CreateContext: resourceLabelAssignmentCreate,
+		ReadContext:   resourceLabelAssignmentRead,
+		DeleteContext: resourceLabelAssignmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceLabelAssignmentImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"label": {
+				Type:        schema.TypeString,
+				Description: "The name of the Khulnasoft label to attach.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"object_type": {
+				Type:         schema.TypeString,
+				Description:  "The type of the object the label is attached to (either 'image', 'function' or 'host'). Defaults to 'image'.",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "image",
+				ValidateFunc: validation.StringInSlice([]string{"image", "function", "host"}, false),
+			},
+			"object_id": {
+				Type:        schema.TypeString,
+				Description: "The identifier of the object the label is attached to. For images, this is the `registry/repository:tag` ID of a `khulnasoft_image`.",
+				Required:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+func resourceLabelAssignmentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+	label := d.Get("label").(string)
+	assignment := expandLabelAssignment(d)
+
+	err := c.AttachKhulnasoftLabel(label, assignment)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(getLabelAssignmentId(label, assignment))
+	return resourceLabelAssignmentRead(ctx, d, m)
+}
+
+func resourceLabelAssignmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG]  inside resourceLabelAssignmentRead")
+	c := m.(*client.Client)
+	label := d.Get("label").(string)
+	assignment := expandLabelAssignment(d)
+
+	_, err := c.GetKhulnasoftLabel(label)
+	if err != nil {
+		if strings.Contains(fmt.Sprintf("%s", err), "404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	// only images expose the labels attached to them, other objects are trusted to keep the label
+	if assignment.Type == "image" {
+		image, err := c.GetImage(getImageUrl(assignment.ID))
+		if err != nil {
+			if strings.Contains(fmt.Sprintf("%s", err), "404") {
+				d.SetId("")
+				return nil
+			}
+			return diag.FromErr(err)
+		}
+
+		attached := false
+		for _, l := range image.Labels {
+			if l == label {
+				attached = true
+				break
+			}
+		}
+		if !attached {
+			log.Printf("[WARN] Khulnasoft label %s is no longer attached to image %s, removing from state", label, assignment.ID)
+			d.SetId("")
+			return nil
+		}
+	}
+
+	d.Set("label", label)
+	d.Set("object_type", assignment.Type)
+	d.Set("object_id", assignment.ID)
+
+	return nil
+}
+
+func resourceLabelAssignmentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	err := c.DetachKhulnasoftLabel(d.Get("label").(string), expandLabelAssignment(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceLabelAssignmentImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), ",", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected label,object_type,object_id", d.Id())
+	}
+
+	d.Set("label", parts[0])
+	d.Set("object_type", parts[1])
+	d.Set("object_id", parts[2])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandLabelAssignment(d *schema.ResourceData) *client.KhulnasoftLabelAssignment {
+	return &client.KhulnasoftLabelAssignment{
+		Type: d.Get("object_type").(string),
+		ID:   d.Get("object_id").(string),
+	}
+}
+
+func getLabelAssignmentId(label string, assignment *client.KhulnasoftLabelAssignment) string {
+	return fmt.Sprintf("%v,%v,%v", label, assignment.Type, assignment.ID)
+}
@@ -0,0 +1,50 @@
+package khulnasoft
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestResourceKhulnasoftLabelAssignment(t *testing.T) {
+	t.Parallel()
+	image := newTestImage()
+	label := acctest.RandomWithPrefix("terraform-test")
+	rootRef := "khulnasoft_label_assignment.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: CheckDestroy(rootRef),
+		Steps: []resource.TestStep{
+			{
+				Config: getImageResource(&image) + testAccLabelAssignment(label),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rootRef, "label", label),
+					resource.TestCheckResourceAttr(rootRef, "object_type", "image"),
+					resource.TestCheckResourceAttrPair(rootRef, "object_id", "khulnasoft_image.test", "id"),
+				),
+			},
+			{
+				ResourceName:      rootRef,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccLabelAssignment(label string) string {
+	return fmt.Sprintf(`
+	resource "khulnasoft_khulnasoft_label" "test" {
+		name = "%s"
+		description = "terraform-test"
+	}
+
+	resource "khulnasoft_label_assignment" "test" {
+		label = khulnasoft_khulnasoft_label.test.name
+		object_id = khulnasoft_image.test.id
+	}
+`, label)
+}
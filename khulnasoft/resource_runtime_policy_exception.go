@@ -0,0 +1,306 @@
+package khulnasoft
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// runtimePolicyExceptionList is a runtime policy allow-list that exceptions can add entries to
+type runtimePolicyExceptionList struct {
+	attribute    string
+	description  string
+	validateFunc schema.SchemaValidateFunc
+	entries      func(crp *client.RuntimePolicy) *[]string
+}
+
+var runtimePolicyExceptionLists = []runtimePolicyExceptionList{
+	{
+		attribute:   "exec_lockdown_white_list",
+		description: "Executables allowed to run despite the drift prevention lockdown (`drift_prevention.exec_lockdown_white_list`).",
+		entries:     func(crp *client.RuntimePolicy) *[]string { return &crp.DriftPrevention.ExecLockdownWhiteList },
+	},
+	{
+		attribute:   "reverse_shell_proc_white_list",
+		description: "Processes allowed to open a reverse shell (`reverse_shell.reverse_shell_proc_white_list`).",
+		entries:     func(crp *client.RuntimePolicy) *[]string { return &crp.ReverseShell.ReverseShellProcWhiteList },
+	},
+	{
+		attribute:    "reverse_shell_ip_white_list",
+		description:  "IP addresses or CIDRs allowed as reverse shell destinations (`reverse_shell.reverse_shell_ip_white_list`).",
+		validateFunc: validateRuntimePolicyIPOrCIDR,
+		entries:      func(crp *client.RuntimePolicy) *[]string { return &crp.ReverseShell.ReverseShellIpWhiteList },
+	},
+	{
+		attribute:   "exceptional_block_files",
+		description: "Files excluded from the blocked files (`file_block.exceptional_block_files`).",
+		entries:     func(crp *client.RuntimePolicy) *[]string { return &crp.FileBlock.ExceptionalBlockFiles },
+	},
+	{
+		attribute:   "allowed_executables",
+		description: "Executables allowed to run (`allowed_executables.allow_executables`).",
+		entries:     func(crp *client.RuntimePolicy) *[]string { return &crp.AllowedExecutables.AllowExecutables },
+	},
+	{
+		attribute:   "allowed_root_executables",
+		description: "Executables allowed to run as root (`allowed_executables.allow_root_executables`).",
+		entries:     func(crp *client.RuntimePolicy) *[]string { return &crp.AllowedExecutables.AllowRootExecutables },
+	},
+	{
+		attribute:   "container_exec_proc_white_list",
+		description: "Processes allowed to run through container exec (`container_exec.container_exec_proc_white_list`).",
+		entries:     func(crp *client.RuntimePolicy) *[]string { return &crp.ContainerExec.ContainerExecProcWhiteList },
+	},
+}
+
+// runtimePolicyExceptionLock serializes the read-modify-write of runtime policies by the exceptions of a run, as
+// several exceptions of the same policy are applied in parallel
+var runtimePolicyExceptionLock sync.Mutex
+
+func resourceRuntimePolicyException() *schema.Resource {
+	exceptionSchema := map[string]*schema.Schema{
+		"policy_name": {
+			Type:        schema.TypeString,
+			Description: "Name of the runtime policy the exception adds entries to. The policy can be of any runtime type.",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"owner": {
+			Type:        schema.TypeString,
+			Description: "Owner of the exception, e.g. the application team requesting it. The exception is identified by its policy and owner.",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"adopt_existing_entries": {
+			Type:        schema.TypeBool,
+			Description: "Whether entries of the exception that are already in the policy become owned by the exception, and are removed from the policy with it. By default such entries are left in the policy, e.g. when moving entries out of the policy configuration into an exception.",
+			Optional:    true,
+			Default:     false,
+		},
+		"unowned_entries": {
+			Type:        schema.TypeSet,
+			Description: "Entries of the exception that were already in the policy when they were added, and are left in the policy when removed from the exception, as `<attribute>:<entry>`.",
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+	for _, list := range runtimePolicyExceptionLists {
+		exceptionSchema[list.attribute] = &schema.Schema{
+			Type:        schema.TypeSet,
+			Description: list.description,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: list.validateFunc,
+			},
+		}
+	}
+
+	return &schema.Resource{
+		Description: "The `khulnasoft_runtime_policy_exception` resource adds entries to the allow-lists of a runtime policy it does not manage, " +
+			"so that application teams own their exceptions without owning the policy. The policy is read, merged and written back on every change, " +
+			"and only the entries added by the exception are removed from it. " +
+			"The resource managing the policy, if any, should ignore the changes of the allow-lists the exceptions add entries to. " +
+			"Exceptions cannot be imported, as the entries they own are only recorded in their state. " +
+			"For the same reason, an exception does not know the entries owned by the other exceptions of the policy: " +
+			"an entry configured in several exceptions is owned by the first one that adds it, or by each of them with `adopt_existing_entries`, " +
+			"and is removed from the policy with its owner until the other exceptions add it again on their next apply. " +
+			"Configure each entry in a single exception of the policy.",
+		CreateContext: resourceRuntimePolicyExceptionCreate,
+		ReadContext:   resourceRuntimePolicyExceptionRead,
+		UpdateContext: resourceRuntimePolicyExceptionUpdate,
+		DeleteContext: resourceRuntimePolicyExceptionDelete,
+		CustomizeDiff: runtimePolicyExceptionCustomizeDiff,
+		Schema:        exceptionSchema,
+	}
+}
+
+// runtimePolicyExceptionCustomizeDiff plans the change of unowned_entries, which is only known once the policy is read
+func runtimePolicyExceptionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	for _, list := range runtimePolicyExceptionLists {
+		if d.HasChange(list.attribute) {
+			return d.SetNewComputed("unowned_entries")
+		}
+	}
+	return nil
+}
+
+func resourceRuntimePolicyExceptionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	policyName := d.Get("policy_name").(string)
+	owner := d.Get("owner").(string)
+
+	err := updateRuntimePolicyExceptionEntries(d, m.(*client.Client), false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(getRuntimePolicyExceptionId(policyName, owner))
+	return resourceRuntimePolicyExceptionRead(ctx, d, m)
+}
+
+func resourceRuntimePolicyExceptionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	crp, err := c.GetRuntimePolicy(d.Get("policy_name").(string))
+	if err != nil {
+		if strings.Contains(fmt.Sprintf("%s", err), "404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	// entries removed from the policy outside of the exception are added again on the next apply
+	for _, list := range runtimePolicyExceptionLists {
+		present := make(map[string]bool)
+		for _, entry := range *list.entries(crp) {
+			present[entry] = true
+		}
+		var entries []string
+		for _, entry := range convertStringArr(d.Get(list.attribute).(*schema.Set).List()) {
+			if present[entry] {
+				entries = append(entries, entry)
+			}
+		}
+		d.Set(list.attribute, entries)
+	}
+
+	return nil
+}
+
+func resourceRuntimePolicyExceptionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	err := updateRuntimePolicyExceptionEntries(d, m.(*client.Client), false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceRuntimePolicyExceptionRead(ctx, d, m)
+}
+
+func resourceRuntimePolicyExceptionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	err := updateRuntimePolicyExceptionEntries(d, m.(*client.Client), true)
+	if err != nil {
+		if strings.Contains(fmt.Sprintf("%s", err), "404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// updateRuntimePolicyExceptionEntries reads the policy of the exception, removes the entries owned by the exception
+// that are no longer configured, adds the configured ones that are missing, and writes the policy back. On delete,
+// all the entries owned by the exception are removed. Entries already in the policy when added are not owned by the
+// exception unless adopt_existing_entries is set. The entries owned by the other exceptions of the policy are only
+// recorded in their state, so an entry removed by its owner is only added again by the other exceptions configuring it
+// on their next apply.
+func updateRuntimePolicyExceptionEntries(d *schema.ResourceData, c *client.Client, removeAll bool) error {
+	runtimePolicyExceptionLock.Lock()
+	defer runtimePolicyExceptionLock.Unlock()
+
+	policyName := d.Get("policy_name").(string)
+	crp, err := c.GetRuntimePolicy(policyName)
+	if err != nil {
+		return err
+	}
+
+	unowned := make(map[string]bool)
+	for _, entry := range convertStringArr(d.Get("unowned_entries").(*schema.Set).List()) {
+		unowned[entry] = true
+	}
+	adopt := d.Get("adopt_existing_entries").(bool)
+
+	changed := false
+	for _, list := range runtimePolicyExceptionLists {
+		o, n := d.GetChange(list.attribute)
+		previous := convertStringArr(o.(*schema.Set).List())
+		configured := make(map[string]bool)
+		if !removeAll {
+			for _, entry := range convertStringArr(n.(*schema.Set).List()) {
+				configured[entry] = true
+			}
+		}
+
+		entries := list.entries(crp)
+		present := make(map[string]bool)
+		for _, entry := range *entries {
+			present[entry] = true
+		}
+
+		removed := make(map[string]bool)
+		for _, entry := range previous {
+			key := list.attribute + ":" + entry
+			if configured[entry] {
+				continue
+			}
+			if !unowned[key] && present[entry] {
+				removed[entry] = true
+			}
+			delete(unowned, key)
+		}
+		if len(removed) > 0 {
+			var kept []string
+			for _, entry := range *entries {
+				if !removed[entry] {
+					kept = append(kept, entry)
+				}
+			}
+			*entries = kept
+			changed = true
+		}
+
+		var added []string
+		for entry := range configured {
+			added = append(added, entry)
+		}
+		sort.Strings(added)
+		for _, entry := range added {
+			key := list.attribute + ":" + entry
+			if !present[entry] {
+				*entries = append(*entries, entry)
+				changed = true
+			} else if !adopt && !containsStringEntry(previous, entry) {
+				unowned[key] = true
+			}
+		}
+	}
+
+	if changed {
+		log.Printf("[DEBUG] Updating the allow-lists of runtime policy %s for the exception of %s", policyName, d.Get("owner").(string))
+		if err := c.UpdateRuntimePolicy(crp); err != nil {
+			return err
+		}
+	}
+
+	var unownedEntries []string
+	for key := range unowned {
+		unownedEntries = append(unownedEntries, key)
+	}
+	sort.Strings(unownedEntries)
+	return d.Set("unowned_entries", unownedEntries)
+}
+
+func containsStringEntry(entries []string, entry string) bool {
+	for _, e := range entries {
+		if e == entry {
+			return true
+		}
+	}
+	return false
+}
+
+func getRuntimePolicyExceptionId(policyName, owner string) string {
+	return fmt.Sprintf("%v,%v", policyName, owner)
+}
@@ -0,0 +1,108 @@
+package khulnasoft
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceKhulnasoftRuntimePolicyException(t *testing.T) {
+	t.Parallel()
+	policyName := acctest.RandomWithPrefix("test-runtime-policy-exception")
+	paymentsRef := "khulnasoft_runtime_policy_exception.payments"
+	searchRef := "khulnasoft_runtime_policy_exception.search"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: CheckDestroy("khulnasoft_runtime_policy_exception.payments"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuntimePolicyException(policyName, `"/usr/bin/payments-worker", "/usr/bin/curl"`, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(paymentsRef, "id", policyName+",payments"),
+					resource.TestCheckResourceAttr(paymentsRef, "exec_lockdown_white_list.#", "2"),
+					resource.TestCheckResourceAttr(paymentsRef, "unowned_entries.#", "1"),
+					resource.TestCheckTypeSetElemAttr(paymentsRef, "unowned_entries.*", "exec_lockdown_white_list:/usr/bin/curl"),
+					resource.TestCheckResourceAttr(searchRef, "reverse_shell_proc_white_list.#", "1"),
+					testAccCheckRuntimePolicyExecLockdownWhiteList(policyName, "/usr/bin/curl", "/usr/bin/payments-worker"),
+				),
+			},
+			{
+				// removing the search exception and an owned entry keeps the entries of the policy itself
+				Config: testAccRuntimePolicyException(policyName, `"/usr/bin/curl"`, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(paymentsRef, "exec_lockdown_white_list.#", "1"),
+					resource.TestCheckResourceAttr(paymentsRef, "unowned_entries.#", "1"),
+					testAccCheckRuntimePolicyExecLockdownWhiteList(policyName, "/usr/bin/curl"),
+				),
+			},
+			{
+				// removing the unowned entry from the exception leaves it in the policy
+				Config: testAccRuntimePolicyException(policyName, `"/usr/bin/payments-worker"`, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(paymentsRef, "unowned_entries.#", "0"),
+					testAccCheckRuntimePolicyExecLockdownWhiteList(policyName, "/usr/bin/curl", "/usr/bin/payments-worker"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRuntimePolicyExecLockdownWhiteList(policyName string, entries ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		c := testAccProvider.Meta().(*client.Client)
+		crp, err := c.GetRuntimePolicy(policyName)
+		if err != nil {
+			return err
+		}
+
+		got := append([]string{}, crp.DriftPrevention.ExecLockdownWhiteList...)
+		sort.Strings(got)
+		sort.Strings(entries)
+		if !equalStringSlices(got, entries) {
+			return fmt.Errorf("exec lockdown white list of runtime policy %s is %v, expected %v", policyName, got, entries)
+		}
+		return nil
+	}
+}
+
+func testAccRuntimePolicyException(policyName, paymentsEntries string, withSearch bool) string {
+	config := fmt.Sprintf(`
+	resource "khulnasoft_container_runtime_policy" "test" {
+		name = "%s"
+		description = "terraform-test"
+		enabled = true
+		drift_prevention {
+			enabled = true
+			exec_lockdown = true
+			exec_lockdown_white_list = ["/usr/bin/curl"]
+		}
+
+		lifecycle {
+			ignore_changes = [drift_prevention, reverse_shell]
+		}
+	}
+
+	resource "khulnasoft_runtime_policy_exception" "payments" {
+		policy_name = khulnasoft_container_runtime_policy.test.name
+		owner = "payments"
+		exec_lockdown_white_list = [%s]
+	}
+`, policyName, paymentsEntries)
+
+	if withSearch {
+		config += `
+	resource "khulnasoft_runtime_policy_exception" "search" {
+		policy_name = khulnasoft_container_runtime_policy.test.name
+		owner = "search"
+		reverse_shell_proc_white_list = ["/usr/bin/search-indexer"]
+	}
+`
+	}
+	return config
+}
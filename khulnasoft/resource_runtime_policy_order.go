@@ -0,0 +1,190 @@
+package khulnasoft
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// runtimePolicyRuntimeTypes are the runtime types of the runtime policies, Windows host policies being host policies
+var runtimePolicyRuntimeTypes = []string{"container", "host", "function", "kubernetes"}
+
+func resourceRuntimePolicyOrder() *schema.Resource {
+	return &schema.Resource{
+		Description: "The `khulnasoft_runtime_policy_order` resource sets the order in which the console evaluates the runtime policies of a runtime type " +
+			"that match the same workload, so that the policy applied to a workload is deterministic and reviewable. " +
+			"The listed policies take precedence, in the given order, over the policies that are not listed, which keep their order. " +
+			"Destroying the resource leaves the order of the console unchanged.",
+		CreateContext: resourceRuntimePolicyOrderCreate,
+		ReadContext:   resourceRuntimePolicyOrderRead,
+		UpdateContext: resourceRuntimePolicyOrderUpdate,
+		DeleteContext: resourceRuntimePolicyOrderDelete,
+		CustomizeDiff: runtimePolicyOrderCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceRuntimePolicyOrderImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"runtime_type": {
+				Type:         schema.TypeString,
+				Description:  "Runtime type of the ordered policies, one of `container`, `host`, `function` or `kubernetes`. Windows host policies are `host` policies.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(runtimePolicyRuntimeTypes, false),
+			},
+			"policies": {
+				Type:        schema.TypeList,
+				Description: "Names of the runtime policies evaluated first, highest precedence first.",
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"evaluation_order": {
+				Type:        schema.TypeList,
+				Description: "Names of all the runtime policies of the runtime type, in the order the console evaluates them.",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func runtimePolicyOrderCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("policies") {
+		return nil
+	}
+	names := make(map[string]bool)
+	for _, name := range convertStringArr(d.Get("policies").([]interface{})) {
+		if names[name] {
+			return fmt.Errorf("runtime policy %q is listed more than once in policies", name)
+		}
+		names[name] = true
+	}
+	if d.HasChange("policies") {
+		return d.SetNewComputed("evaluation_order")
+	}
+	return nil
+}
+
+func resourceRuntimePolicyOrderCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+	runtimeType := d.Get("runtime_type").(string)
+
+	err := updateRuntimePolicyOrder(c, runtimeType, convertStringArr(d.Get("policies").([]interface{})))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(runtimeType)
+	return resourceRuntimePolicyOrderRead(ctx, d, m)
+}
+
+func resourceRuntimePolicyOrderRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	order, err := c.GetRuntimePolicyOrder(d.Id())
+	if err != nil {
+		if strings.Contains(fmt.Sprintf("%s", err), "404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	// the listed policies are the first ones of the order, a policy moved before them shows up as a diff
+	listed := len(d.Get("policies").([]interface{}))
+	if listed == 0 || listed > len(order.Policies) {
+		listed = len(order.Policies)
+	}
+	d.Set("runtime_type", d.Id())
+	d.Set("policies", order.Policies[:listed])
+	d.Set("evaluation_order", order.Policies)
+
+	return nil
+}
+
+func resourceRuntimePolicyOrderUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	if d.HasChange("policies") {
+		err := updateRuntimePolicyOrder(c, d.Id(), convertStringArr(d.Get("policies").([]interface{})))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceRuntimePolicyOrderRead(ctx, d, m)
+}
+
+func resourceRuntimePolicyOrderDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// the console always evaluates the policies in some order, the current one is kept
+	d.SetId("")
+	return nil
+}
+
+func resourceRuntimePolicyOrderImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if err := validateRuntimeType(d.Id()); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// updateRuntimePolicyOrder moves the listed policies first, in the given order, before the other policies of the
+// runtime type, which keep their order
+func updateRuntimePolicyOrder(c *client.Client, runtimeType string, policies []string) error {
+	current, err := c.GetRuntimePolicyOrder(runtimeType)
+	if err != nil {
+		return err
+	}
+
+	order := mergeRuntimePolicyOrder(current.Policies, policies)
+	if len(order) != len(current.Policies) {
+		var missing []string
+		existing := make(map[string]bool)
+		for _, name := range current.Policies {
+			existing[name] = true
+		}
+		for _, name := range policies {
+			if !existing[name] {
+				missing = append(missing, name)
+			}
+		}
+		return fmt.Errorf("%s runtime policies not found: %s", runtimeType, strings.Join(missing, ", "))
+	}
+
+	return c.UpdateRuntimePolicyOrder(&client.RuntimePolicyOrder{
+		RuntimeType: runtimeType,
+		Policies:    order,
+	})
+}
+
+func mergeRuntimePolicyOrder(current, policies []string) []string {
+	listed := make(map[string]bool)
+	for _, name := range policies {
+		listed[name] = true
+	}
+	order := append([]string{}, policies...)
+	for _, name := range current {
+		if !listed[name] {
+			order = append(order, name)
+		}
+	}
+	return order
+}
+
+func validateRuntimeType(runtimeType string) error {
+	for _, t := range runtimePolicyRuntimeTypes {
+		if runtimeType == t {
+			return nil
+		}
+	}
+	return fmt.Errorf("unexpected runtime type %q, expected one of %s", runtimeType, strings.Join(runtimePolicyRuntimeTypes, ", "))
+}
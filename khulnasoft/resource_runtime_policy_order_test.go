@@ -0,0 +1,115 @@
+package khulnasoft
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestMergeRuntimePolicyOrder(t *testing.T) {
+	cases := []struct {
+		name     string
+		current  []string
+		policies []string
+		expected []string
+	}{
+		{
+			name:     "listed policies first",
+			current:  []string{"a", "b", "c", "d"},
+			policies: []string{"c", "a"},
+			expected: []string{"c", "a", "b", "d"},
+		},
+		{
+			name:     "all policies listed",
+			current:  []string{"a", "b"},
+			policies: []string{"b", "a"},
+			expected: []string{"b", "a"},
+		},
+		{
+			name:     "unknown policy",
+			current:  []string{"a", "b"},
+			policies: []string{"x"},
+			expected: []string{"x", "a", "b"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := mergeRuntimePolicyOrder(tc.current, tc.policies)
+			if !equalStringSlices(got, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestResourceKhulnasoftRuntimePolicyOrder(t *testing.T) {
+	t.Parallel()
+	first := acctest.RandomWithPrefix("test-runtime-policy-order")
+	second := acctest.RandomWithPrefix("test-runtime-policy-order")
+	rootRef := "khulnasoft_runtime_policy_order.test"
+	dataRef := "data.khulnasoft_runtime_policy_order.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuntimePolicyOrder(first, second, "first", "second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rootRef, "id", "container"),
+					resource.TestCheckResourceAttr(rootRef, "policies.#", "2"),
+					resource.TestCheckResourceAttr(rootRef, "evaluation_order.0", first),
+					resource.TestCheckResourceAttr(rootRef, "evaluation_order.1", second),
+					resource.TestCheckResourceAttr(dataRef, "priorities."+first, "1"),
+					resource.TestCheckResourceAttr(dataRef, "priorities."+second, "2"),
+				),
+			},
+			{
+				Config: testAccRuntimePolicyOrder(first, second, "second", "first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rootRef, "evaluation_order.0", second),
+					resource.TestCheckResourceAttr(rootRef, "evaluation_order.1", first),
+					resource.TestCheckResourceAttr(dataRef, "priorities."+second, "1"),
+				),
+			},
+			{
+				ResourceName:      rootRef,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the imported order lists all the policies of the runtime type
+				ImportStateVerifyIgnore: []string{"policies"},
+			},
+		},
+	})
+}
+
+func testAccRuntimePolicyOrder(first, second, firstRef, secondRef string) string {
+	return fmt.Sprintf(`
+	resource "khulnasoft_container_runtime_policy" "first" {
+		name = "%s"
+		description = "terraform-test"
+		enabled = true
+	}
+
+	resource "khulnasoft_container_runtime_policy" "second" {
+		name = "%s"
+		description = "terraform-test"
+		enabled = true
+	}
+
+	resource "khulnasoft_runtime_policy_order" "test" {
+		runtime_type = "container"
+		policies = [
+			khulnasoft_container_runtime_policy.%s.name,
+			khulnasoft_container_runtime_policy.%s.name,
+		]
+	}
+
+	data "khulnasoft_runtime_policy_order" "test" {
+		runtime_type = khulnasoft_runtime_policy_order.test.runtime_type
+		depends_on   = [khulnasoft_runtime_policy_order.test]
+	}
+`, first, second, firstRef, secondRef)
+}
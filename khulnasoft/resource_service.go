@@ -16,6 +16,7 @@ func resourceService() *schema.Resource {
 		ReadContext:   resourceServiceRead,
 		UpdateContext: resourceServiceUpdate,
 		DeleteContext: resourceServiceDelete,
+		CustomizeDiff: resourceServiceCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -73,9 +74,10 @@ func resourceService() *schema.Resource {
 				Required:    true,
 			},
 			"scope_expression": {
-				Type:        schema.TypeString,
-				Description: "Logical expression of how to compute the dependency of the scope variables.",
-				Optional:    true,
+				Type:         schema.TypeString,
+				Description:  "Logical expression of how to compute the dependency of the scope variables, e.g. `v1 && (v2 || v3)`.",
+				Optional:     true,
+				ValidateFunc: validateScopeExpression,
 			},
 			"scope_variables": {
 				Type:        schema.TypeList,
@@ -101,6 +103,7 @@ func resourceService() *schema.Resource {
 				},
 				Optional: true,
 			},
+			"scope_conditions": scopeConditionsSchema("scope_expression", "scope_variables"),
 			"policies": {
 				Type: schema.TypeList,
 				Elem: &schema.Schema{
@@ -225,8 +228,13 @@ func resourceServiceRead(ctx context.Context, d *schema.ResourceData, m interfac
 	d.Set("enforce", service.Enforce)
 	d.Set("priority", service.MembershipRules.Priority)
 	d.Set("target", service.MembershipRules.Target)
-	d.Set("scope_expression", service.MembershipRules.Scope.Expression)
-	d.Set("scope_variables", flattenScopeVariables(service.MembershipRules.Scope.Variables))
+	// scope_expression and scope_variables are left empty when the scope is configured with scope_conditions
+	if _, ok := d.GetOk("scope_conditions"); ok {
+		flattenScopeConditions(d, service.MembershipRules.Scope.Expression, scopeVariablesFromScope(service.MembershipRules.Scope.Variables))
+	} else {
+		d.Set("scope_expression", service.MembershipRules.Scope.Expression)
+		d.Set("scope_variables", flattenScopeVariables(service.MembershipRules.Scope.Variables))
+	}
 	d.Set("not_evaluated_count", service.NotEvaluatedCount)
 	d.Set("unregistered_count", service.UnregisteredCount)
 	d.Set("is_registered", service.IsRegistered)
@@ -240,7 +248,7 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, m interf
 	c := m.(*client.Client)
 	name := d.Get("name").(string)
 
-	if d.HasChanges("description", "monitoring", "policies", "enforce", "application_scopes", "target", "priority", "scope_expression", "scope_variables") {
+	if d.HasChanges("description", "monitoring", "policies", "enforce", "application_scopes", "target", "priority", "scope_expression", "scope_variables", "scope_conditions") {
 		service := expandService(d)
 		err := c.UpdateService(service)
 		if err == nil {
@@ -387,6 +395,11 @@ func expandService(d *schema.ResourceData) *client.Service {
 		}
 	}
 	scope.Variables = variables
+
+	scopeConditions, ok := d.GetOk("scope_conditions")
+	if ok {
+		scope = scopeFromVariables(compileScopeConditions(scopeConditions.([]interface{})))
+	}
 	membershipRules.Scope = scope
 	service.MembershipRules = membershipRules
 
@@ -425,3 +438,11 @@ func flattenScopeVariables(variables []client.Variable) []map[string]interface{}
 
 	return specs
 }
+
+// resourceServiceCustomizeDiff validates the scope of the service
+func resourceServiceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if err := validateScopeDiff(d, "scope_expression", "scope_variables"); err != nil {
+		return err
+	}
+	return validateScopeConditionsDiff(d)
+}
@@ -0,0 +1,250 @@
+package khulnasoft
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// trustedBaseImageSet is a named set of trusted base images, referenced by image assurance policies through its
+// reference, the JSON encoding of the set
+type trustedBaseImageSet struct {
+	Name   string                     `json:"name"`
+	Images []client.BaseImagesTrusted `json:"images"`
+}
+
+func resourceTrustedBaseImages() *schema.Resource {
+	return &schema.Resource{
+		Description: "The `khulnasoft_trusted_base_images` resource defines a named set of trusted base images once, to be shared by image assurance policies. " +
+			"Policies list the `reference` of the set in `trusted_base_images_sets`, and the provider adds the images of the set to their `trusted_base_images`. " +
+			"The reference changes with the images of the set, so every policy referencing the set is updated with it. " +
+			"The set is only recorded in the Terraform state, the console only knowing the trusted base images of each policy, and cannot be imported.",
+		CreateContext: resourceTrustedBaseImagesCreate,
+		ReadContext:   resourceTrustedBaseImagesRead,
+		UpdateContext: resourceTrustedBaseImagesUpdate,
+		DeleteContext: resourceTrustedBaseImagesDelete,
+		CustomizeDiff: trustedBaseImagesCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Name of the set.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Description of the set.",
+				Optional:    true,
+			},
+			"trusted_base_images": {
+				Type:        schema.TypeSet,
+				Description: "The trusted base images of the set.",
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"registry": {
+							Type:        schema.TypeString,
+							Description: "Name of the registry of the image, checked to exist when the set is applied.",
+							Required:    true,
+						},
+						"imagename": {
+							Type:        schema.TypeString,
+							Description: "Name of the image, e.g. `library/alpine`.",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"reference": {
+				Type:        schema.TypeString,
+				Description: "Reference of the set, to list in `trusted_base_images_sets` of `khulnasoft_image_assurance_policy`.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// trustedBaseImagesCustomizeDiff plans the reference of the set, so that the policies referencing it plan the change
+// of its images
+func trustedBaseImagesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("name") || !d.NewValueKnown("trusted_base_images") {
+		return d.SetNewComputed("reference")
+	}
+	reference, err := trustedBaseImageSetReference(d.Get("name").(string), d.Get("trusted_base_images").(*schema.Set).List())
+	if err != nil {
+		return err
+	}
+	if reference != d.Get("reference").(string) {
+		return d.SetNew("reference", reference)
+	}
+	return nil
+}
+
+func resourceTrustedBaseImagesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := applyTrustedBaseImageSet(d, m.(*client.Client)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(d.Get("name").(string))
+	return resourceTrustedBaseImagesRead(ctx, d, m)
+}
+
+func resourceTrustedBaseImagesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// the set only lives in the state
+	return nil
+}
+
+func resourceTrustedBaseImagesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChange("trusted_base_images") {
+		if err := applyTrustedBaseImageSet(d, m.(*client.Client)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceTrustedBaseImagesRead(ctx, d, m)
+}
+
+func resourceTrustedBaseImagesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+// applyTrustedBaseImageSet checks that the registries of the images exist and records the reference of the set
+func applyTrustedBaseImageSet(d *schema.ResourceData, c *client.Client) error {
+	images := d.Get("trusted_base_images").(*schema.Set).List()
+	checked := make(map[string]bool)
+	for _, image := range expandTrustedBaseImages(images) {
+		if checked[image.Registry] {
+			continue
+		}
+		if _, err := c.GetRegistry(image.Registry); err != nil {
+			return fmt.Errorf("trusted base image %s/%s: %v", image.Registry, image.Imagename, err)
+		}
+		checked[image.Registry] = true
+	}
+
+	reference, err := trustedBaseImageSetReference(d.Get("name").(string), images)
+	if err != nil {
+		return err
+	}
+	return d.Set("reference", reference)
+}
+
+func expandTrustedBaseImages(images []interface{}) []client.BaseImagesTrusted {
+	expanded := make([]client.BaseImagesTrusted, 0, len(images))
+	for _, v := range images {
+		image := v.(map[string]interface{})
+		expanded = append(expanded, client.BaseImagesTrusted{
+			Registry:  image["registry"].(string),
+			Imagename: image["imagename"].(string),
+		})
+	}
+	return expanded
+}
+
+// trustedBaseImageSetReference encodes the set, its images sorted so that the reference only changes with them
+func trustedBaseImageSetReference(name string, images []interface{}) (string, error) {
+	set := trustedBaseImageSet{Name: name, Images: expandTrustedBaseImages(images)}
+	sort.Slice(set.Images, func(i, j int) bool {
+		if set.Images[i].Registry != set.Images[j].Registry {
+			return set.Images[i].Registry < set.Images[j].Registry
+		}
+		return set.Images[i].Imagename < set.Images[j].Imagename
+	})
+	data, err := json.Marshal(set)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func parseTrustedBaseImageSetReference(reference string) (*trustedBaseImageSet, error) {
+	var set trustedBaseImageSet
+	if err := json.Unmarshal([]byte(reference), &set); err != nil || set.Name == "" {
+		return nil, fmt.Errorf("invalid trusted base images set reference %q, expected the reference attribute of a khulnasoft_trusted_base_images", reference)
+	}
+	return &set, nil
+}
+
+func validateTrustedBaseImageSetReference(v interface{}, k string) ([]string, []error) {
+	if _, err := parseTrustedBaseImageSetReference(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%s: %v", k, err)}
+	}
+	return nil, nil
+}
+
+// applyTrustedBaseImageSets adds the images of the sets of trusted_base_images_sets to the trusted base images of
+// the policy, without duplicates
+func applyTrustedBaseImageSets(d *schema.ResourceData, iap *client.AssurancePolicy) error {
+	sets, ok := d.GetOk("trusted_base_images_sets")
+	if !ok {
+		return nil
+	}
+	for _, reference := range convertStringArr(sets.([]interface{})) {
+		set, err := parseTrustedBaseImageSetReference(reference)
+		if err != nil {
+			return err
+		}
+		for _, image := range set.Images {
+			if !containsTrustedBaseImage(iap.TrustedBaseImages, image) {
+				iap.TrustedBaseImages = append(iap.TrustedBaseImages, image)
+			}
+		}
+	}
+	return nil
+}
+
+func containsTrustedBaseImage(images []client.BaseImagesTrusted, image client.BaseImagesTrusted) bool {
+	for _, i := range images {
+		if i == image {
+			return true
+		}
+	}
+	return false
+}
+
+// flattenTrustedBaseImageList flattens the trusted base images of a policy that are not added by its sets, unless
+// they are also configured in trusted_base_images. The sets are cleared when the console lacks some of their
+// images, so that the missing images show up as a diff.
+func flattenTrustedBaseImageList(d *schema.ResourceData, images []client.BaseImagesTrusted) []map[string]interface{} {
+	sets, ok := d.GetOk("trusted_base_images_sets")
+	if !ok {
+		return flattenTrustedBaseImages(images)
+	}
+	references := convertStringArr(sets.([]interface{}))
+
+	fromSets := make(map[client.BaseImagesTrusted]bool)
+	for _, reference := range references {
+		set, err := parseTrustedBaseImageSetReference(reference)
+		if err != nil {
+			log.Printf("[WARN] trusted_base_images_sets: %v", err)
+			return flattenTrustedBaseImages(images)
+		}
+		for _, image := range set.Images {
+			fromSets[image] = true
+		}
+	}
+	configured := expandTrustedBaseImages(d.Get("trusted_base_images").(*schema.Set).List())
+
+	var inline []client.BaseImagesTrusted
+	for _, image := range images {
+		if fromSets[image] {
+			delete(fromSets, image)
+			if !containsTrustedBaseImage(configured, image) {
+				continue
+			}
+		}
+		inline = append(inline, image)
+	}
+	if len(fromSets) > 0 {
+		d.Set("trusted_base_images_sets", nil)
+	}
+	return flattenTrustedBaseImages(inline)
+}
@@ -0,0 +1,129 @@
+package khulnasoft
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestTrustedBaseImageSetReference(t *testing.T) {
+	alpine := map[string]interface{}{"registry": "Docker Hub", "imagename": "library/alpine"}
+	debian := map[string]interface{}{"registry": "Docker Hub", "imagename": "library/debian"}
+
+	reference, err := trustedBaseImageSetReference("golden", []interface{}{debian, alpine})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other, _ := trustedBaseImageSetReference("golden", []interface{}{alpine, debian}); other != reference {
+		t.Errorf("expected the reference not to depend on the order of the images, got %s and %s", reference, other)
+	}
+
+	set, err := parseTrustedBaseImageSetReference(reference)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if set.Name != "golden" || len(set.Images) != 2 || set.Images[0].Imagename != "library/alpine" {
+		t.Errorf("unexpected set %+v", set)
+	}
+
+	for _, invalid := range []string{"golden", `{"images": []}`, ""} {
+		if _, errs := validateTrustedBaseImageSetReference(invalid, "trusted_base_images_sets.0"); len(errs) == 0 {
+			t.Errorf("expected %q to be rejected", invalid)
+		}
+	}
+}
+
+func TestApplyTrustedBaseImageSets(t *testing.T) {
+	alpine := map[string]interface{}{"registry": "Docker Hub", "imagename": "library/alpine"}
+	debian := map[string]interface{}{"registry": "Docker Hub", "imagename": "library/debian"}
+	nginx := map[string]interface{}{"registry": "Docker Hub", "imagename": "library/nginx"}
+	golden, _ := trustedBaseImageSetReference("golden", []interface{}{alpine, debian})
+	web, _ := trustedBaseImageSetReference("web", []interface{}{debian, nginx})
+
+	d := schema.TestResourceDataRaw(t, assurancePolicySchema(client.AssuranceTypeImage), map[string]interface{}{
+		"name":                     "production",
+		"trusted_base_images":      []interface{}{alpine},
+		"trusted_base_images_sets": []interface{}{golden, web},
+	})
+	iap := &client.AssurancePolicy{TrustedBaseImages: expandTrustedBaseImages([]interface{}{alpine})}
+	if err := applyTrustedBaseImageSets(d, iap); err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, image := range iap.TrustedBaseImages {
+		names = append(names, image.Imagename)
+	}
+	if fmt.Sprint(names) != "[library/alpine library/debian library/nginx]" {
+		t.Errorf("expected each image once, got %v", names)
+	}
+
+	// the images of the sets are hidden unless configured inline
+	flattened := flattenTrustedBaseImageList(d, iap.TrustedBaseImages)
+	if len(flattened) != 1 || flattened[0]["imagename"] != "library/alpine" {
+		t.Errorf("expected only the inline image, got %v", flattened)
+	}
+	if len(d.Get("trusted_base_images_sets").([]interface{})) != 2 {
+		t.Error("expected the sets to be kept when the console has all their images")
+	}
+
+	// an image of a set removed on the console clears the sets
+	flattened = flattenTrustedBaseImageList(d, iap.TrustedBaseImages[:2])
+	if len(flattened) != 1 {
+		t.Errorf("expected only the inline image, got %v", flattened)
+	}
+	if len(d.Get("trusted_base_images_sets").([]interface{})) != 0 {
+		t.Error("expected the sets to be cleared when the console lacks one of their images")
+	}
+}
+
+func TestResourceKhulnasoftTrustedBaseImages(t *testing.T) {
+	t.Parallel()
+	name := acctest.RandomWithPrefix("terraform-test")
+	rootRef := "khulnasoft_trusted_base_images.test"
+	policyRef := "khulnasoft_image_assurance_policy.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: CheckDestroy(policyRef),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustedBaseImages(name, "library/alpine"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rootRef, "name", name),
+					resource.TestCheckResourceAttrSet(rootRef, "reference"),
+					resource.TestCheckResourceAttr(policyRef, "trusted_base_images.#", "0"),
+					resource.TestCheckResourceAttr(policyRef, "trusted_base_images_sets.#", "1"),
+				),
+			},
+			{
+				Config: testAccTrustedBaseImages(name, "library/debian"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rootRef, "trusted_base_images.#", "1"),
+					resource.TestCheckResourceAttr(policyRef, "trusted_base_images_sets.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTrustedBaseImages(name, imagename string) string {
+	return fmt.Sprintf(`
+	resource "khulnasoft_trusted_base_images" "test" {
+		name = "%s"
+		trusted_base_images {
+			registry  = "Docker Hub"
+			imagename = "%s"
+		}
+	}
+
+	resource "khulnasoft_image_assurance_policy" "test" {
+		name                        = "%s"
+		application_scopes          = ["Global"]
+		trusted_base_images_enabled = true
+		trusted_base_images_sets    = [khulnasoft_trusted_base_images.test.reference]
+	}`, name, imagename, name)
+}
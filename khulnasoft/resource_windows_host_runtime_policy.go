@@ -0,0 +1,119 @@
+package khulnasoft
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// windowsHostRuntimePolicyControls are the runtime policy controls supported by khulnasoft_windows_host_runtime_policy,
+// the host controls without the Linux only ones
+var windowsHostRuntimePolicyControls = runtimePolicyPlatformControls("windows", hostRuntimePolicyControls)
+
+func resourceWindowsHostRuntimePolicy() *schema.Resource {
+	policySchema := runtimePolicySchema("host", windowsHostRuntimePolicyControls, map[string]*schema.Schema{
+		"enable_ip_reputation": {
+			Type:        schema.TypeBool,
+			Description: "",
+			Default:     true,
+			Optional:    true,
+		},
+	})
+	policySchema["name"].Description = "Name of the Windows host runtime policy"
+	policySchema["description"].Description = "The description of the Windows host runtime policy"
+	// host policies start auditing as soon as an auditing block is configured
+	policySchema["auditing"].Elem.(*schema.Resource).Schema["enabled"].Default = true
+
+	return &schema.Resource{
+		CreateContext: resourceWindowsHostRuntimePolicyCreate,
+		ReadContext:   resourceWindowsHostRuntimePolicyRead,
+		UpdateContext: resourceWindowsHostRuntimePolicyUpdate,
+		DeleteContext: resourceWindowsHostRuntimePolicyDelete,
+		CustomizeDiff: runtimePolicyCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: policySchema,
+	}
+}
+
+func resourceWindowsHostRuntimePolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+	name := d.Get("name").(string)
+
+	crp := expandWindowsHostRuntimePolicy(d)
+	if err := applyBaseRuntimePolicy(d, c, crp); err != nil {
+		return diag.FromErr(err)
+	}
+	err := c.CreateRuntimePolicy(crp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(name)
+	return resourceWindowsHostRuntimePolicyRead(ctx, d, m)
+}
+
+func resourceWindowsHostRuntimePolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	crp, err := c.GetRuntimePolicy(d.Id())
+	if err != nil {
+		if strings.Contains(fmt.Sprintf("%s", err), "404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	flattenWindowsHostRuntimePolicy(d, crp)
+	d.SetId(crp.Name)
+
+	return nil
+}
+
+func resourceWindowsHostRuntimePolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+	name := d.Get("name").(string)
+
+	if d.HasChangesExcept("name") {
+		crp := expandWindowsHostRuntimePolicy(d)
+		if err := applyBaseRuntimePolicy(d, c, crp); err != nil {
+			return diag.FromErr(err)
+		}
+		err := c.UpdateRuntimePolicy(crp)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId(name)
+	}
+
+	return resourceWindowsHostRuntimePolicyRead(ctx, d, m)
+}
+
+func resourceWindowsHostRuntimePolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+	name := d.Get("name").(string)
+
+	err := c.DeleteRuntimePolicy(name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandWindowsHostRuntimePolicy(d *schema.ResourceData) *client.RuntimePolicy {
+	crp := expandRuntimePolicy(d, "host")
+	expandRuntimePolicyControls(d, crp, windowsHostRuntimePolicyControls)
+	return crp
+}
+
+func flattenWindowsHostRuntimePolicy(d *schema.ResourceData, crp *client.RuntimePolicy) {
+	flattenRuntimePolicy(d, crp, windowsHostRuntimePolicyControls)
+}
@@ -0,0 +1,114 @@
+package khulnasoft
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestResourceKhulnasoftWindowsHostRuntimePolicyCreate(t *testing.T) {
+	t.Parallel()
+	var runtimePolicy = client.RuntimePolicy{
+		Name:        acctest.RandomWithPrefix("test-windows-host-runtime-policy"),
+		Description: "This is a test description of windows host runtime policy",
+		Enabled:     true,
+		Enforce:     false,
+	}
+
+	rootRef := windowsHostRuntimePolicyRef("test")
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: CheckDestroy("khulnasoft_windows_host_runtime_policy.test"),
+		Steps: []resource.TestStep{
+			{
+				Config: getWindowsHostRuntimePolicyResource(runtimePolicy),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rootRef, "name", runtimePolicy.Name),
+					resource.TestCheckResourceAttr(rootRef, "description", runtimePolicy.Description),
+					resource.TestCheckResourceAttr(rootRef, "enabled", fmt.Sprintf("%v", runtimePolicy.Enabled)),
+					resource.TestCheckResourceAttr(rootRef, "enforce", fmt.Sprintf("%v", runtimePolicy.Enforce)),
+					resource.TestCheckResourceAttr(rootRef, "runtime_type", "host"),
+					resource.TestCheckResourceAttr(rootRef, "registry_access_monitoring.0.enabled", "true"),
+					resource.TestCheckResourceAttr(rootRef, "registry_access_monitoring.0.monitored_registry_paths.#", "1"),
+					resource.TestCheckResourceAttr(rootRef, "readonly_registry.0.enabled", "true"),
+					resource.TestCheckResourceAttr(rootRef, "readonly_registry.0.readonly_registry_paths.#", "1"),
+					resource.TestCheckResourceAttr(rootRef, "system_integrity_protection.0.windows_services_monitoring", "true"),
+				),
+			},
+			{
+				ResourceName:      "khulnasoft_windows_host_runtime_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceKhulnasoftWindowsHostRuntimePolicyRejectsLinuxControls(t *testing.T) {
+	t.Parallel()
+	var runtimePolicy = client.RuntimePolicy{
+		Name:        acctest.RandomWithPrefix("test-windows-host-runtime-policy"),
+		Description: "This is a test description of windows host runtime policy",
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      getWindowsHostRuntimePolicyResourceLinuxControls(runtimePolicy),
+				ExpectError: regexp.MustCompile(`An argument named "linux_capabilities" is not expected here`),
+			},
+		},
+	})
+}
+
+func windowsHostRuntimePolicyRef(name string) string {
+	return fmt.Sprintf("khulnasoft_windows_host_runtime_policy.%v", name)
+}
+
+func getWindowsHostRuntimePolicyResource(policy client.RuntimePolicy) string {
+	return fmt.Sprintf(`
+	resource "khulnasoft_windows_host_runtime_policy" "test" {
+		name = "%s"
+		description = "%s"
+		enabled = "%v"
+		enforce = "%v"
+
+		registry_access_monitoring {
+			enabled                   = true
+			monitored_registry_paths  = ["HKEY_LOCAL_MACHINE\\SOFTWARE"]
+			monitored_registry_modify = true
+			monitored_registry_delete = true
+		}
+		readonly_registry {
+			enabled                 = true
+			readonly_registry_paths = ["HKEY_LOCAL_MACHINE\\SYSTEM"]
+		}
+		system_integrity_protection {
+			enabled                     = true
+			windows_services_monitoring = true
+		}
+	}
+`, policy.Name, policy.Description, policy.Enabled, policy.Enforce)
+}
+
+func getWindowsHostRuntimePolicyResourceLinuxControls(policy client.RuntimePolicy) string {
+	return fmt.Sprintf(`
+	resource "khulnasoft_windows_host_runtime_policy" "test" {
+		name = "%s"
+		description = "%s"
+
+		linux_capabilities {
+			enabled                   = true
+			remove_linux_capabilities = ["NET_RAW"]
+		}
+	}
+`, policy.Name, policy.Description)
+}
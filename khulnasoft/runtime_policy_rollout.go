@@ -0,0 +1,236 @@
+package khulnasoft
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// runtimePolicyAuditStage is the enforcement stage of a staged rollout before its first stage starts
+const runtimePolicyAuditStage = "audit"
+
+// runtimePolicyRolloutStage is a stage of the enforcement rollout of a runtime policy
+type runtimePolicyRolloutStage struct {
+	name              string
+	startsAt          time.Time
+	enforce           bool
+	applicationScopes []string
+}
+
+func runtimePolicyEnforcementRolloutSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"enforcement_rollout": {
+			Type:        schema.TypeList,
+			Description: "Staged rollout of the policy enforcement. The policy is audit only until the first stage starts, then each stage applies its enforcement from its start date. The stage in effect is evaluated on every plan, so a stage boundary that has passed shows up as a planned change. Conflicts with `enforce`.",
+			MaxItems:    1,
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"stage": {
+						Type:        schema.TypeList,
+						Description: "Rollout stages, in chronological order.",
+						Required:    true,
+						MinItems:    1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"name": {
+									Type:         schema.TypeString,
+									Description:  "Name of the stage, defaults to `stage-<n>` where n is the position of the stage starting at 1.",
+									Optional:     true,
+									ValidateFunc: validation.StringNotInSlice([]string{runtimePolicyAuditStage}, false),
+								},
+								"starts_at": {
+									Type:         schema.TypeString,
+									Description:  "Date the stage starts, in RFC3339 format.",
+									Required:     true,
+									ValidateFunc: validation.IsRFC3339Time,
+								},
+								"enforce": {
+									Type:        schema.TypeBool,
+									Description: "Whether the policy is enforced during the stage.",
+									Optional:    true,
+									Default:     true,
+								},
+								"application_scopes": {
+									Type:        schema.TypeList,
+									Description: "Application scopes the policy is narrowed to during the stage, e.g. to enforce on a subset of the scopes first. The policy `application_scopes` apply when empty.",
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"enforcement_stage": {
+			Type:        schema.TypeString,
+			Description: "Stage of the `enforcement_rollout` applied to the policy, `audit` before the first stage starts.",
+			Computed:    true,
+		},
+		"next_enforcement_stage_at": {
+			Type:        schema.TypeString,
+			Description: "Start date of the next stage of the `enforcement_rollout`, empty once the last stage started.",
+			Computed:    true,
+		},
+	}
+}
+
+// runtimePolicyCustomizeDiff validates the scopes of runtime policies, plans the base policy digest and plans the
+// enforcement stage change of runtime policies with an enforcement rollout when a stage boundary passes
+func runtimePolicyCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if err := runtimePolicyScopeCustomizeDiff(d); err != nil {
+		return err
+	}
+	if err := runtimePolicyBaseCustomizeDiff(d, m.(*client.Client)); err != nil {
+		return err
+	}
+
+	if !d.NewValueKnown("enforcement_rollout") {
+		return nil
+	}
+
+	stages, err := getRuntimePolicyRolloutStages(d.Get("enforcement_rollout").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	if stages == nil {
+		if d.Get("enforcement_stage").(string) == "" {
+			return nil
+		}
+		if err := d.SetNew("enforcement_stage", ""); err != nil {
+			return err
+		}
+		return d.SetNew("next_enforcement_stage_at", "")
+	}
+
+	if !d.GetRawConfig().GetAttr("enforce").IsNull() {
+		return fmt.Errorf("enforce cannot be set together with enforcement_rollout, the rollout stages define the enforcement")
+	}
+
+	stage, next := currentRuntimePolicyRolloutStage(stages, time.Now())
+	if d.Get("enforcement_stage").(string) != stage.name {
+		if err := d.SetNew("enforcement_stage", stage.name); err != nil {
+			return err
+		}
+	}
+	nextAt := ""
+	if next != nil {
+		nextAt = next.startsAt.UTC().Format(time.RFC3339)
+	}
+	if d.Get("next_enforcement_stage_at").(string) != nextAt {
+		return d.SetNew("next_enforcement_stage_at", nextAt)
+	}
+	return nil
+}
+
+// expandRuntimePolicyEnforcementRollout applies the enforcement of the current rollout stage to the runtime policy
+func expandRuntimePolicyEnforcementRollout(d *schema.ResourceData, crp *client.RuntimePolicy) {
+	stages, err := getRuntimePolicyRolloutStages(d.Get("enforcement_rollout").([]interface{}))
+	if err != nil || stages == nil {
+		return
+	}
+
+	stage, _ := currentRuntimePolicyRolloutStage(stages, time.Now())
+	crp.Enforce = stage.enforce
+	crp.EnforceAfterDays = 0
+	if len(stage.applicationScopes) > 0 {
+		crp.ApplicationScopes = stage.applicationScopes
+	}
+}
+
+// flattenRuntimePolicyEnforcementRollout reads the enforcement of a runtime policy. With an enforcement rollout,
+// enforce and application_scopes are driven by the rollout stage: they are kept as configured and a policy that no
+// longer matches its stage clears enforcement_stage, so the next plan applies the stage again.
+func flattenRuntimePolicyEnforcementRollout(d *schema.ResourceData, crp *client.RuntimePolicy) {
+	stages, err := getRuntimePolicyRolloutStages(d.Get("enforcement_rollout").([]interface{}))
+	if err != nil || stages == nil {
+		d.Set("enforce", crp.Enforce)
+		d.Set("application_scopes", crp.ApplicationScopes)
+		return
+	}
+
+	stageName := d.Get("enforcement_stage").(string)
+	stage := runtimePolicyRolloutStage{name: runtimePolicyAuditStage}
+	for _, s := range stages {
+		if s.name == stageName {
+			stage = s
+		}
+	}
+	applicationScopes := stage.applicationScopes
+	if len(applicationScopes) == 0 {
+		applicationScopes = convertStringArr(d.Get("application_scopes").([]interface{}))
+	}
+	if crp.Enforce != stage.enforce || !equalStringSlices(crp.ApplicationScopes, applicationScopes) {
+		d.Set("enforcement_stage", "")
+	}
+}
+
+func getRuntimePolicyRolloutStages(rollout []interface{}) ([]runtimePolicyRolloutStage, error) {
+	if len(rollout) == 0 || rollout[0] == nil {
+		return nil, nil
+	}
+
+	var stages []runtimePolicyRolloutStage
+	names := make(map[string]bool)
+	for i, s := range rollout[0].(map[string]interface{})["stage"].([]interface{}) {
+		v := s.(map[string]interface{})
+
+		startsAt, err := time.Parse(time.RFC3339, v["starts_at"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("invalid starts_at of enforcement rollout stage %d: %v", i+1, err)
+		}
+		if len(stages) > 0 && !startsAt.After(stages[len(stages)-1].startsAt) {
+			return nil, fmt.Errorf("enforcement rollout stage %d must start after stage %d", i+1, i)
+		}
+
+		name := v["name"].(string)
+		if name == "" {
+			name = fmt.Sprintf("stage-%d", i+1)
+		}
+		if names[name] {
+			return nil, fmt.Errorf("duplicate enforcement rollout stage name %q", name)
+		}
+		names[name] = true
+
+		stages = append(stages, runtimePolicyRolloutStage{
+			name:              name,
+			startsAt:          startsAt,
+			enforce:           v["enforce"].(bool),
+			applicationScopes: convertStringArr(v["application_scopes"].([]interface{})),
+		})
+	}
+	return stages, nil
+}
+
+// currentRuntimePolicyRolloutStage returns the stage in effect at the given time, the audit stage before the first
+// stage starts, and the next stage if any
+func currentRuntimePolicyRolloutStage(stages []runtimePolicyRolloutStage, now time.Time) (runtimePolicyRolloutStage, *runtimePolicyRolloutStage) {
+	current := runtimePolicyRolloutStage{name: runtimePolicyAuditStage}
+	for i, stage := range stages {
+		if now.Before(stage.startsAt) {
+			return current, &stages[i]
+		}
+		current = stage
+	}
+	return current, nil
+}
+
+func equalStringSlices(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,90 @@
+package khulnasoft
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCurrentRuntimePolicyRolloutStage(t *testing.T) {
+	rollout := []interface{}{map[string]interface{}{
+		"stage": []interface{}{
+			map[string]interface{}{"name": "", "starts_at": "2026-11-01T00:00:00Z", "enforce": false, "application_scopes": []interface{}{"canary"}},
+			map[string]interface{}{"name": "canary", "starts_at": "2026-11-08T00:00:00Z", "enforce": true, "application_scopes": []interface{}{"canary"}},
+			map[string]interface{}{"name": "everywhere", "starts_at": "2026-11-15T00:00:00+02:00", "enforce": true, "application_scopes": []interface{}{}},
+		},
+	}}
+	stages, err := getRuntimePolicyRolloutStages(rollout)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if names := []string{stages[0].name, stages[1].name, stages[2].name}; !reflect.DeepEqual(names, []string{"stage-1", "canary", "everywhere"}) {
+		t.Fatalf("unexpected stage names %v", names)
+	}
+
+	first := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+	second := time.Date(2026, 11, 8, 0, 0, 0, 0, time.UTC)
+	last := time.Date(2026, 11, 14, 22, 0, 0, 0, time.UTC)
+	cases := []struct {
+		now     time.Time
+		stage   string
+		enforce bool
+		scopes  []string
+		next    string
+	}{
+		{now: first.Add(-time.Second), stage: "audit", next: "stage-1"},
+		{now: first, stage: "stage-1", scopes: []string{"canary"}, next: "canary"},
+		{now: first.Add(time.Second), stage: "stage-1", scopes: []string{"canary"}, next: "canary"},
+		{now: second.Add(-time.Second), stage: "stage-1", scopes: []string{"canary"}, next: "canary"},
+		{now: second, stage: "canary", enforce: true, scopes: []string{"canary"}, next: "everywhere"},
+		{now: last.Add(-time.Second), stage: "canary", enforce: true, scopes: []string{"canary"}, next: "everywhere"},
+		{now: last, stage: "everywhere", enforce: true},
+		{now: last.Add(time.Second), stage: "everywhere", enforce: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.now.Format(time.RFC3339), func(t *testing.T) {
+			stage, next := currentRuntimePolicyRolloutStage(stages, tc.now)
+			if stage.name != tc.stage || stage.enforce != tc.enforce || !equalStringSlices(stage.applicationScopes, tc.scopes) {
+				t.Errorf("expected stage %s enforcing %v on %v, got %s enforcing %v on %v", tc.stage, tc.enforce, tc.scopes, stage.name, stage.enforce, stage.applicationScopes)
+			}
+			nextName := ""
+			if next != nil {
+				nextName = next.name
+			}
+			if nextName != tc.next {
+				t.Errorf("expected next stage %q, got %q", tc.next, nextName)
+			}
+		})
+	}
+}
+
+func TestGetRuntimePolicyRolloutStagesErrors(t *testing.T) {
+	stage := func(name, startsAt string) interface{} {
+		return map[string]interface{}{"name": name, "starts_at": startsAt, "enforce": true, "application_scopes": []interface{}{}}
+	}
+	cases := []struct {
+		stages []interface{}
+		err    string
+	}{
+		{stages: []interface{}{stage("", "2026-11-08T00:00:00Z"), stage("", "2026-11-08T00:00:00Z")}, err: "enforcement rollout stage 2 must start after stage 1"},
+		{stages: []interface{}{stage("", "2026-11-08T00:00:00Z"), stage("", "2026-11-01T00:00:00Z")}, err: "enforcement rollout stage 2 must start after stage 1"},
+		{stages: []interface{}{stage("canary", "2026-11-01T00:00:00Z"), stage("canary", "2026-11-08T00:00:00Z")}, err: `duplicate enforcement rollout stage name "canary"`},
+		{stages: []interface{}{stage("", "2026-11-01T00:00:00Z"), stage("stage-1", "2026-11-08T00:00:00Z")}, err: `duplicate enforcement rollout stage name "stage-1"`},
+		{stages: []interface{}{stage("", "next week")}, err: "invalid starts_at of enforcement rollout stage 1"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.err, func(t *testing.T) {
+			_, err := getRuntimePolicyRolloutStages([]interface{}{map[string]interface{}{"stage": tc.stages}})
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("expected an error containing %q, got %v", tc.err, err)
+			}
+		})
+	}
+
+	if stages, err := getRuntimePolicyRolloutStages(nil); stages != nil || err != nil {
+		t.Errorf("expected no stages without a rollout, got %v, %v", stages, err)
+	}
+}
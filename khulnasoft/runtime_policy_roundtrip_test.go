@@ -0,0 +1,102 @@
+package khulnasoft
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// runtimePolicyRoundTrips are the runtime policy resources with how they expand and flatten a client.RuntimePolicy
+var runtimePolicyRoundTrips = map[string]struct {
+	resource func() *schema.Resource
+	expand   func(d *schema.ResourceData) *client.RuntimePolicy
+	flatten  func(d *schema.ResourceData, crp *client.RuntimePolicy)
+}{
+	"khulnasoft_container_runtime_policy":    {resourceContainerRuntimePolicy, expandContainerRuntimePolicy, flattenContainerRuntimePolicy},
+	"khulnasoft_host_runtime_policy":         {resourceHostRuntimePolicy, expandHostRuntimePolicy, flattenHostRuntimePolicy},
+	"khulnasoft_function_runtime_policy":     {resourceFunctionRuntimePolicy, expandFunctionRuntimePolicy, flattenFunctionRuntimePolicy},
+	"khulnasoft_windows_host_runtime_policy": {resourceWindowsHostRuntimePolicy, expandWindowsHostRuntimePolicy, flattenWindowsHostRuntimePolicy},
+	"khulnasoft_kubernetes_runtime_policy":   {resourceKubernetesRuntimePolicy, expandKubernetesRuntimePolicy, flattenKubernetesRuntimePolicy},
+}
+
+// TestRuntimePolicyRoundTrip reads a recorded console response into the state of every runtime policy resource,
+// expands the state back into the policy sent to the console and reads it again: both reads must be identical, or
+// the resource would report a diff right after an apply, and the console managed fields must not be sent.
+func TestRuntimePolicyRoundTrip(t *testing.T) {
+	recorded := readRecordedRuntimePolicy(t, "testdata/runtime_policy.json")
+
+	for name, roundTrip := range runtimePolicyRoundTrips {
+		t.Run(name, func(t *testing.T) {
+			r := roundTrip.resource()
+
+			read := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+			read.SetId(recorded.Name)
+			roundTrip.flatten(read, recorded)
+
+			sent := roundTrip.expand(read)
+			if sent.Author != "" || sent.Created != "" || !sent.Updated.IsZero() || sent.Lastupdate != 0 ||
+				sent.Version != "" || sent.VpatchVersion != "" || sent.EnforceSchedulerAddedOn != 0 {
+				t.Errorf("console managed fields are sent back: %+v", sent)
+			}
+
+			reread := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+			reread.SetId(recorded.Name)
+			sent.Author = recorded.Author
+			sent.Created = recorded.Created
+			sent.Updated = recorded.Updated
+			sent.Lastupdate = recorded.Lastupdate
+			sent.Version = recorded.Version
+			sent.VpatchVersion = recorded.VpatchVersion
+			sent.EnforceSchedulerAddedOn = recorded.EnforceSchedulerAddedOn
+			roundTrip.flatten(reread, sent)
+
+			want := read.State().Attributes
+			got := reread.State().Attributes
+			for k, v := range want {
+				if got[k] != v {
+					t.Errorf("%s: read %q, read after round trip %q", k, v, got[k])
+				}
+			}
+			for k, v := range got {
+				if _, ok := want[k]; !ok {
+					t.Errorf("%s: not read, read after round trip %q", k, v)
+				}
+			}
+		})
+	}
+}
+
+func TestRuntimePolicyServerManagedFields(t *testing.T) {
+	for name, roundTrip := range runtimePolicyRoundTrips {
+		r := roundTrip.resource()
+		d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+		for _, field := range runtimePolicyServerManagedFields {
+			attribute, ok := r.Schema[field]
+			if !ok {
+				t.Errorf("%s: missing console managed attribute %s", name, field)
+				continue
+			}
+			if !attribute.Computed || attribute.Default != nil {
+				t.Errorf("%s: console managed attribute %s must be computed without default", name, field)
+			}
+			if attribute.DiffSuppressFunc == nil || !attribute.DiffSuppressFunc(field, "1", "2", d) {
+				t.Errorf("%s: the diff of console managed attribute %s is not suppressed", name, field)
+			}
+		}
+	}
+}
+
+func readRecordedRuntimePolicy(t *testing.T, path string) *client.RuntimePolicy {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var crp client.RuntimePolicy
+	if err := json.Unmarshal(data, &crp); err != nil {
+		t.Fatal(err)
+	}
+	return &crp
+}
@@ -0,0 +1,2811 @@
+package khulnasoft
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// runtimePolicyControl is a group of runtime policy attributes shared by the runtime policy resources, together
+// with how they are expanded into and flattened from a client.RuntimePolicy.
+type runtimePolicyControl struct {
+	schema  func() map[string]*schema.Schema
+	expand  func(d *schema.ResourceData, crp *client.RuntimePolicy)
+	flatten func(d *schema.ResourceData, crp *client.RuntimePolicy)
+}
+
+// runtimePolicyControls are the controls a runtime policy resource can declare, keyed by the attribute they are
+// configured with. Adding a client.RuntimePolicy field here makes it available to every resource declaring it.
+var runtimePolicyControls = map[string]runtimePolicyControl{
+	"audit_brute_force_login":       runtimePolicyBoolControl("audit_brute_force_login", "Detects brute force login attempts", func(crp *client.RuntimePolicy) *bool { return &crp.AuditBruteForceLogin }),
+	"block_container_exec":          runtimePolicyBoolControl("block_container_exec", "If true, exec into a container is prevented.", func(crp *client.RuntimePolicy) *bool { return &crp.BlockContainerExec }),
+	"block_disallowed_images":       runtimePolicyBoolControl("block_disallowed_images", "", func(crp *client.RuntimePolicy) *bool { return &crp.BlockDisallowedImages }),
+	"block_fileless_exec":           runtimePolicyBoolControl("block_fileless_exec", "Detect and prevent running in-memory execution", func(crp *client.RuntimePolicy) *bool { return &crp.BlockFilelessExec }),
+	"block_non_compliant_workloads": runtimePolicyBoolControl("block_non_compliant_workloads", "If true, running containers in non-compliant pods is prevented.", func(crp *client.RuntimePolicy) *bool { return &crp.BlockNonCompliantWorkloads }),
+	"block_non_k8s_containers":      runtimePolicyBoolControl("block_non_k8s_containers", "If true, running non-kubernetes containers is prevented.", func(crp *client.RuntimePolicy) *bool { return &crp.BlockNonK8sContainers }),
+	"enable_crypto_mining_dns":      runtimePolicyBoolControl("enable_crypto_mining_dns", "", func(crp *client.RuntimePolicy) *bool { return &crp.EnableCryptoMiningDns }),
+	"enable_ip_reputation":          runtimePolicyBoolControl("enable_ip_reputation", "", func(crp *client.RuntimePolicy) *bool { return &crp.EnableIPReputation }),
+	"enable_port_scan_protection":   runtimePolicyBoolControl("enable_port_scan_protection", "", func(crp *client.RuntimePolicy) *bool { return &crp.EnablePortScanProtection }),
+	"no_new_privileges":             runtimePolicyBoolControl("no_new_privileges", "", func(crp *client.RuntimePolicy) *bool { return &crp.NoNewPrivileges }),
+	"only_registered_images":        runtimePolicyBoolControl("only_registered_images", "", func(crp *client.RuntimePolicy) *bool { return &crp.OnlyRegisteredImages }),
+	"enable_fork_guard": {
+		schema:  runtimePolicyForkGuardSchema,
+		expand:  expandRuntimePolicyForkGuard,
+		flatten: flattenRuntimePolicyForkGuard,
+	},
+	"file_integrity_monitoring": {
+		schema: runtimePolicyFileIntegrityMonitoringSchema,
+		expand: expandRuntimePolicyFileIntegrityMonitoring,
+		flatten: func(d *schema.ResourceData, crp *client.RuntimePolicy) {
+			d.Set("file_integrity_monitoring", flattenFileIntegrityMonitoring(crp.FileIntegrityMonitoring))
+		},
+	},
+	"malware_scan_options": {
+		schema: runtimePolicyMalwareScanOptionsSchema,
+		expand: expandRuntimePolicyMalwareScanOptions,
+		flatten: func(d *schema.ResourceData, crp *client.RuntimePolicy) {
+			d.Set("malware_scan_options", flattenMalwareScanOptions(crp.MalwareScanOptions))
+		},
+	},
+	"failed_kubernetes_checks": {
+		schema: runtimePolicyFailedKubernetesChecksSchema,
+		expand: expandRuntimePolicyFailedKubernetesChecks,
+		flatten: func(d *schema.ResourceData, crp *client.RuntimePolicy) {
+			d.Set("failed_kubernetes_checks", flattenFailedKubernetesChecks(crp.FailedKubernetesChecks))
+		},
+	},
+	"reverse_shell": {
+		schema: runtimePolicyReverseShellSchema,
+		expand: expandRuntimePolicyReverseShell,
+		flatten: func(d *schema.ResourceData, crp *client.RuntimePolicy) {
+			d.Set("reverse_shell", flattenReverseShell(crp.ReverseShell))
+		},
+	},
+	"container_exec": {
+		schema: runtimePolicyContainerExecSchema,
+		expand: expandRuntimePolicyContainerExec,
+		flatten: func(d *schema.ResourceData, crp *client.RuntimePolicy) {
+			d.Set("container_exec", flattenContainerExec(crp.ContainerExec))
+		},
+	},
+	"system_integrity_protection": {
+		schema: runtimePolicySystemIntegrityProtectionSchema,
+		expand: expandRuntimePolicySystemIntegrityProtection,
+		flatten: func(d *schema.ResourceData, crp *client.RuntimePolicy) {
+			d.Set("system_integrity_protection", flattenSystemIntegrityProtection(crp.SystemIntegrityProtection))
+		},
+	},
+	"readonly_registry": {
+		schema: runtimePolicyReadonlyRegistrySchema,
+		expand: expandRuntimePolicyReadonlyRegistry,
+		flatten: func(d *schema.ResourceData, crp *client.RuntimePolicy) {
+			d.Set("readonly_registry", flattenReadonlyRegistry(crp.ReadonlyRegistry))
+		},
+	},
+	"registry_access_monitoring": {
+		schema: runtimePolicyRegistryAccessMonitoringSchema,
+		expand: expandRuntimePolicyRegistryAccessMonitoring,
+		flatten: func(d *schema.ResourceData, crp *client.RuntimePolicy) {
+			d.Set("registry_access_monitoring", flattenRegistryAccessMonitoring(crp.RegistryAccessMonitoring))
+		},
+	},
+	"readonly_files": {
+		schema: runtimePolicyReadonlyFilesSchema,
+		expand: expandRuntimePolicyReadonlyFiles,
+		flatten: func(d *schema.ResourceData, crp *client.RuntimePolicy) {
+			d.Set("readonly_files", flattenReadonlyFiles(crp.ReadonlyFiles))
+		},
+	},
+	"tripwire": {
+		schema: runtimePolicyTripwireSchema,
+		expand: expandRuntimePolicyTripwire,
+		flatten: func(d *schema.ResourceData, crp *client.RuntimePolicy) {
+			d.Set("tripwire", flattenTripwire(crp.Tripwire))
+		},
+	},
+	"port_block": {
+		schema: runtimePolicyPortBlockSchema,
+		expand: expandRuntimePolicyPortBlock,
+		flatten: func(d *schema.ResourceData, crp *client.RuntimePolicy) {
+			d.Set("port_block", flattenPortBlock(crp.PortBlock))
+		},
+	},
+	"linux_capabilities": {
+		schema: runtimePolicyLinuxCapabilitiesSchema,
+		expand: expandRuntimePolicyLinuxCapabilities,
+		flatten: func(d *schema.ResourceData, crp *client.RuntimePolicy) {
+			d.Set("linux_capabilities", flattenLinuxCapabilities(crp.LinuxCapabilities))
+		},
+	},
+	"package_block": {
+		schema: runtimePolicyPackageBlockSchema,
+		expand: expandRuntimePolicyPackageBlock,
+		flatten: func(d *schema.ResourceData, crp *client.RuntimePolicy) {
+			d.Set("package_block", flattenPackageBlock(crp.PackageBlock))
+		},
+	},
+	"file_block": {
+		schema: runtimePolicyFileBlockSchema,
+		expand: expandRuntimePolicyFileBlock,
+		flatten: func(d *schema.ResourceData, crp *client.RuntimePolicy) {
+			d.Set("file_block", flattenFileBlock(crp.FileBlock))
+		},
+	},
+	"whitelisted_os_users": {
+		schema: runtimePolicyWhitelistedOsUsersSchema,
+		expand: expandRuntimePolicyWhitelistedOsUsers,
+		flatten: func(d *schema.ResourceData, crp *client.RuntimePolicy) {
+			d.Set("whitelisted_os_users", flattenWhitelistedOSUsers(crp.WhitelistedOsUsers))
+		},
+	},
+	"blacklisted_os_users": {
+		schema: runtimePolicyBlacklistedOsUsersSchema,
+		expand: expandRuntimePolicyBlacklistedOsUsers,
+		flatten: func(d *schema.ResourceData, crp *client.RuntimePolicy) {
+			d.Set("blacklisted_os_users", flattenBlacklistedOSUsers(crp.BlacklistedOsUsers))
+		},
+	},
+	"auditing": {
+		schema: runtimePolicyAuditingSchema,
+		expand: expandRuntimePolicyAuditing,
+		flatten: func(d *schema.ResourceData, crp *client.RuntimePolicy) {
+			d.Set("auditing", flattenAuditing(crp.Auditing))
+		},
+	},
+	"limit_container_privileges": {
+		schema: runtimePolicyLimitContainerPrivilegesSchema,
+		expand: expandRuntimePolicyLimitContainerPrivileges,
+		flatten: func(d *schema.ResourceData, crp *client.RuntimePolicy) {
+			d.Set("limit_container_privileges", flattenLimitContainerPrivileges(crp.LimitContainerPrivileges))
+		},
+	},
+	"restricted_volumes": {
+		schema: runtimePolicyRestrictedVolumesSchema,
+		expand: expandRuntimePolicyRestrictedVolumes,
+		flatten: func(d *schema.ResourceData, crp *client.RuntimePolicy) {
+			d.Set("restricted_volumes", flattenRestrictedVolumes(crp.RestrictedVolumes))
+		},
+	},
+	"executable_blacklist": {
+		schema: runtimePolicyExecutableBlacklistSchema,
+		expand: expandRuntimePolicyExecutableBlacklist,
+		flatten: func(d *schema.ResourceData, crp *client.RuntimePolicy) {
+			d.Set("executable_blacklist", flattenExecutableBlacklist(crp.ExecutableBlacklist))
+		},
+	},
+	"drift_prevention": {
+		schema: runtimePolicyDriftPreventionSchema,
+		expand: expandRuntimePolicyDriftPrevention,
+		flatten: func(d *schema.ResourceData, crp *client.RuntimePolicy) {
+			d.Set("drift_prevention", flattenDriftPrevention(crp.DriftPrevention))
+		},
+	},
+	"allowed_registries": {
+		schema: runtimePolicyAllowedRegistriesSchema,
+		expand: expandRuntimePolicyAllowedRegistries,
+		flatten: func(d *schema.ResourceData, crp *client.RuntimePolicy) {
+			d.Set("allowed_registries", flattenAllowedRegistries(crp.AllowedRegistries))
+		},
+	},
+	"allowed_executables": {
+		schema: runtimePolicyAllowedExecutablesSchema,
+		expand: expandRuntimePolicyAllowedExecutables,
+		flatten: func(d *schema.ResourceData, crp *client.RuntimePolicy) {
+			d.Set("allowed_executables", flattenAllowedExecutables(crp.AllowedExecutables))
+		},
+	},
+}
+
+// runtimePolicySchema returns the schema of a runtime policy resource of the given runtime type: the attributes common
+// to every runtime policy, those of the declared controls and the resource specific ones, which take precedence.
+func runtimePolicySchema(runtimeType string, controls []string, resourceSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	policySchema := runtimePolicyBaseSchema(runtimeType)
+	for k, v := range runtimePolicyServerManagedSchema() {
+		policySchema[k] = v
+	}
+	for k, v := range runtimePolicyEnforcementRolloutSchema() {
+		policySchema[k] = v
+	}
+	for _, name := range controls {
+		for k, v := range getRuntimePolicyControl(name).schema() {
+			policySchema[k] = v
+		}
+	}
+	for k, v := range resourceSchema {
+		policySchema[k] = v
+	}
+	addBasePolicySchema(policySchema, "runtime")
+	return policySchema
+}
+
+// expandRuntimePolicy expands the attributes common to every runtime policy, the controls are expanded separately
+// with expandRuntimePolicyControls so resource specific attributes can be expanded in between
+func expandRuntimePolicy(d *schema.ResourceData, runtimeType string) *client.RuntimePolicy {
+	crp := client.RuntimePolicy{
+		Name:        d.Get("name").(string),
+		RuntimeType: runtimeType,
+	}
+
+	description, ok := d.GetOk("description")
+	if ok {
+		crp.Description = description.(string)
+	}
+
+	applicationScopes, ok := d.GetOk("application_scopes")
+	if ok {
+		crp.ApplicationScopes = convertStringArr(applicationScopes.([]interface{}))
+	}
+
+	scopeExpression, ok := d.GetOk("scope_expression")
+	if ok {
+		crp.Scope.Expression = scopeExpression.(string)
+	}
+
+	variables := make([]client.Variable, 0)
+	variableMap, ok := d.GetOk("scope_variables")
+	if ok {
+		for _, v := range variableMap.([]interface{}) {
+			ifc := v.(map[string]interface{})
+			variables = append(variables, client.Variable{
+				Attribute: ifc["attribute"].(string),
+				Name:      ifc["name"].(string),
+				Value:     ifc["value"].(string),
+			})
+		}
+	}
+	crp.Scope.Variables = variables
+
+	// the scope block takes precedence over scope_expression and scope_variables, and conflicts with scope_conditions
+	scopeMap, ok := d.GetOk("scope")
+	if ok {
+		v := scopeMap.([]interface{})[0].(map[string]interface{})
+
+		crp.Scope = client.Scope{
+			Expression: v["expression"].(string),
+			Variables:  flattenVariables(v["variables"].([]interface{})),
+		}
+	}
+
+	scopeConditions, ok := d.GetOk("scope_conditions")
+	if ok {
+		crp.Scope = scopeFromVariables(compileScopeConditions(scopeConditions.([]interface{})))
+	}
+
+	bypassScopeMap, ok := d.GetOk("bypass_scope")
+	if ok {
+		v := bypassScopeMap.([]interface{})[0].(map[string]interface{})
+
+		crp.BypassScope = client.BypassScope{
+			Enabled: v["enabled"].(bool),
+		}
+		if scopes := v["scope"].([]interface{}); len(scopes) > 0 && scopes[0] != nil {
+			scope := scopes[0].(map[string]interface{})
+			crp.BypassScope.Scope = client.Scope{
+				Expression: scope["expression"].(string),
+				Variables:  flattenVariables(scope["variables"].([]interface{})),
+			}
+		}
+	}
+
+	excludeApplicationScopes, ok := d.GetOk("exclude_application_scopes")
+	if ok {
+		var scopes []string
+		for _, scope := range excludeApplicationScopes.([]interface{}) {
+			scopes = append(scopes, scope.(string))
+		}
+		crp.ExcludeApplicationScopes = scopes
+	} else {
+		// If "exclude_application_scopes" is not provided, set it to an empty array
+		crp.ExcludeApplicationScopes = []string{}
+	}
+
+	enabled, ok := d.GetOk("enabled")
+	if ok {
+		crp.Enabled = enabled.(bool)
+	}
+
+	enforce, ok := d.GetOk("enforce")
+	if ok {
+		crp.Enforce = enforce.(bool)
+	}
+
+	enforceAfterDays, ok := d.GetOk("enforce_after_days")
+	if ok {
+		crp.EnforceAfterDays = enforceAfterDays.(int)
+	}
+
+	defaultSecurityProfile, ok := d.GetOk("default_security_profile")
+	if ok {
+		crp.DefaultSecurityProfile = defaultSecurityProfile.(string)
+	}
+
+	registry, ok := d.GetOk("registry")
+	if ok {
+		crp.Registry = registry.(string)
+	}
+
+	Type, ok := d.GetOk("type")
+	if ok {
+		crp.Type = Type.(string)
+	}
+
+	digest, ok := d.GetOk("digest")
+	if ok {
+		crp.Digest = digest.(string)
+	}
+
+	resourceName, ok := d.GetOk("resource_name")
+	if ok {
+		crp.ResourceName = resourceName.(string)
+	}
+
+	resourceType, ok := d.GetOk("resource_type")
+	if ok {
+		crp.ResourceType = resourceType.(string)
+	}
+
+	cve, ok := d.GetOk("cve")
+	if ok {
+		crp.Cve = cve.(string)
+	}
+
+	repoName, ok := d.GetOk("repo_name")
+	if ok {
+		crp.RepoName = repoName.(string)
+	}
+
+	imageName, ok := d.GetOk("image_name")
+	if ok {
+		crp.ImageName = imageName.(string)
+	}
+
+	permission, ok := d.GetOk("permission")
+	if ok {
+		crp.Permission = permission.(string)
+	}
+
+	isAuditChecked, ok := d.GetOk("is_audit_checked")
+	if ok {
+		crp.IsAuditChecked = isAuditChecked.(bool)
+	}
+
+	isOOTBPolicy, ok := d.GetOk("is_ootb_policy")
+	if ok {
+		crp.IsOOTBPolicy = isOOTBPolicy.(bool)
+	}
+
+	isAutoGenerated, ok := d.GetOk("is_auto_generated")
+	if ok {
+		crp.IsAutoGenerated = isAutoGenerated.(bool)
+	}
+
+	runtimeMode, ok := d.GetOk("runtime_mode")
+	if ok {
+		crp.RuntimeMode = runtimeMode.(int)
+	}
+
+	runtimeTypeOverride, ok := d.GetOk("runtime_type")
+	if ok {
+		crp.RuntimeType = runtimeTypeOverride.(string)
+	}
+
+	expandRuntimePolicyEnforcementRollout(d, &crp)
+
+	return &crp
+}
+
+// expandRuntimePolicyControls expands the declared controls into the runtime policy
+func expandRuntimePolicyControls(d *schema.ResourceData, crp *client.RuntimePolicy, controls []string) {
+	for _, name := range controls {
+		getRuntimePolicyControl(name).expand(d, crp)
+	}
+}
+
+// flattenRuntimePolicy sets the attributes common to every runtime policy and those of the declared controls
+func flattenRuntimePolicy(d *schema.ResourceData, crp *client.RuntimePolicy, controls []string) {
+	d.Set("name", crp.Name)
+	d.Set("description", crp.Description)
+	d.Set("scope_expression", crp.Scope.Expression)
+	d.Set("scope_variables", flattenScopeVariables(crp.Scope.Variables))
+	flattenScopeConditions(d, crp.Scope.Expression, scopeVariablesFromScope(crp.Scope.Variables))
+	d.Set("bypass_scope", flattenBypassScope(crp.BypassScope))
+	d.Set("exclude_application_scopes", crp.ExcludeApplicationScopes)
+	d.Set("enabled", crp.Enabled)
+	flattenRuntimePolicyEnforcementRollout(d, crp)
+	d.Set("enforce_after_days", crp.EnforceAfterDays)
+	d.Set("default_security_profile", crp.DefaultSecurityProfile)
+	d.Set("registry", crp.Registry)
+	d.Set("type", crp.Type)
+	d.Set("digest", crp.Digest)
+	d.Set("resource_name", crp.ResourceName)
+	d.Set("resource_type", crp.ResourceType)
+	d.Set("cve", crp.Cve)
+	d.Set("repo_name", crp.RepoName)
+	d.Set("image_name", crp.ImageName)
+	d.Set("permission", crp.Permission)
+	d.Set("is_audit_checked", crp.IsAuditChecked)
+	d.Set("is_ootb_policy", crp.IsOOTBPolicy)
+	d.Set("is_auto_generated", crp.IsAutoGenerated)
+	d.Set("runtime_mode", crp.RuntimeMode)
+	d.Set("runtime_type", crp.RuntimeType)
+	flattenRuntimePolicyServerManagedFields(d, crp)
+
+	for _, name := range controls {
+		getRuntimePolicyControl(name).flatten(d, crp)
+	}
+}
+
+// linuxOnlyRuntimePolicyControls are the controls enforced on Linux workloads only
+var linuxOnlyRuntimePolicyControls = []string{
+	"block_fileless_exec",
+	"enable_fork_guard",
+	"limit_container_privileges",
+	"linux_capabilities",
+	"no_new_privileges",
+	"package_block",
+	"reverse_shell",
+	"tripwire",
+}
+
+// windowsOnlyRuntimePolicyControls are the controls enforced on Windows workloads only
+var windowsOnlyRuntimePolicyControls = []string{
+	"readonly_registry",
+	"registry_access_monitoring",
+}
+
+// runtimePolicyPlatformControls returns the given controls without those the platform ("linux" or "windows") does not
+// support, so a policy of that platform rejects the blocks of the other one as unsupported arguments
+func runtimePolicyPlatformControls(platform string, controls []string) []string {
+	var unsupported []string
+	switch platform {
+	case "linux":
+		unsupported = windowsOnlyRuntimePolicyControls
+	case "windows":
+		unsupported = linuxOnlyRuntimePolicyControls
+	default:
+		panic(fmt.Sprintf("unknown runtime policy platform %q", platform))
+	}
+
+	excluded := make(map[string]bool, len(unsupported))
+	for _, name := range unsupported {
+		excluded[name] = true
+	}
+
+	platformControls := make([]string, 0, len(controls))
+	for _, name := range controls {
+		if !excluded[name] {
+			platformControls = append(platformControls, name)
+		}
+	}
+	return platformControls
+}
+
+func getRuntimePolicyControl(name string) runtimePolicyControl {
+	control, ok := runtimePolicyControls[name]
+	if !ok {
+		panic(fmt.Sprintf("unknown runtime policy control %q", name))
+	}
+	return control
+}
+
+// runtimePolicyServerManagedFields are the runtime policy attributes managed by the console. They are read from the
+// console but never sent on create or update, and their changes are not reported as drift.
+var runtimePolicyServerManagedFields = []string{
+	"author",
+	"created",
+	"enforce_scheduler_added_on",
+	"lastupdate",
+	"updated",
+	"version",
+	"vpatch_version",
+}
+
+func runtimePolicyServerManagedSchema() map[string]*schema.Schema {
+	descriptions := map[string]string{
+		"author":                     "Username of the account that created the policy.",
+		"created":                    "Creation date of the policy.",
+		"enforce_scheduler_added_on": "Time the enforcement of the policy was scheduled, when `enforce_after_days` is set.",
+		"lastupdate":                 "Time of the last update of the policy, as a Unix timestamp.",
+		"updated":                    "Date of the last update of the policy.",
+		"version":                    "Version of the policy.",
+		"vpatch_version":             "vShield version of the policy.",
+	}
+
+	serverManagedSchema := make(map[string]*schema.Schema, len(runtimePolicyServerManagedFields))
+	for _, name := range runtimePolicyServerManagedFields {
+		attributeType := schema.TypeString
+		if name == "enforce_scheduler_added_on" || name == "lastupdate" {
+			attributeType = schema.TypeInt
+		}
+		serverManagedSchema[name] = &schema.Schema{
+			Type:        attributeType,
+			Description: descriptions[name],
+			// optional for compatibility with configurations that used to set it
+			Optional:   true,
+			Computed:   true,
+			Deprecated: fmt.Sprintf("%s is managed by the console, the configured value is ignored", name),
+			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+				return true
+			},
+		}
+	}
+	return serverManagedSchema
+}
+
+func flattenRuntimePolicyServerManagedFields(d *schema.ResourceData, crp *client.RuntimePolicy) {
+	d.Set("author", crp.Author)
+	d.Set("created", crp.Created)
+	d.Set("enforce_scheduler_added_on", crp.EnforceSchedulerAddedOn)
+	d.Set("lastupdate", crp.Lastupdate)
+	updated := ""
+	if !crp.Updated.IsZero() {
+		updated = crp.Updated.Format(time.RFC3339)
+	}
+	d.Set("updated", updated)
+	d.Set("version", crp.Version)
+	d.Set("vpatch_version", crp.VpatchVersion)
+}
+
+func runtimePolicyBaseSchema(runtimeType string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Description: fmt.Sprintf("Name of the %s runtime policy", runtimeType),
+			Required:    true,
+			ForceNew:    true,
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Description: fmt.Sprintf("The description of the %s runtime policy", runtimeType),
+			Optional:    true,
+		},
+		"application_scopes": {
+			Type:        schema.TypeList,
+			Description: "Indicates the application scope of the service.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Optional: true,
+			Computed: true,
+		},
+		"scope_expression": {
+			Type:         schema.TypeString,
+			Description:  "Logical expression of how to compute the dependency of the scope variables, e.g. `v1 && (v2 || v3)`.",
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validateScopeExpression,
+		},
+		"scope_variables": {
+			Type:        schema.TypeList,
+			Description: "List of scope attributes.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"attribute": {
+						Type:        schema.TypeString,
+						Description: "Class of supported scope.",
+						Required:    true,
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Description: "Name assigned to the attribute.",
+						Optional:    true,
+					},
+					"value": {
+						Type:        schema.TypeString,
+						Description: "Value assigned to the attribute.",
+						Required:    true,
+					},
+				},
+			},
+			Optional: true,
+			Computed: true,
+		},
+		"scope": {
+			Type:        schema.TypeList,
+			Description: "Scope configuration.",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"expression": {
+						Type:         schema.TypeString,
+						Description:  "Scope expression.",
+						Required:     true,
+						ValidateFunc: validateScopeExpression,
+					},
+					"variables": {
+						Type:        schema.TypeList,
+						Description: "List of variables in the scope.",
+						Required:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"attribute": {
+									Type:        schema.TypeString,
+									Description: "Variable attribute.",
+									Required:    true,
+								},
+								"value": {
+									Type:        schema.TypeString,
+									Description: "Variable value.",
+									Required:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"scope_conditions": scopeConditionsSchema("scope_expression", "scope_variables", "scope"),
+		"bypass_scope": {
+			Type:        schema.TypeList,
+			Description: "Bypass scope configuration.",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:        schema.TypeBool,
+						Description: "Whether bypassing the scope is enabled.",
+						Optional:    true,
+					},
+					"scope": {
+						Type:        schema.TypeList,
+						Description: "Scope configuration.",
+						Optional:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"expression": {
+									Type:         schema.TypeString,
+									Description:  "Scope expression.",
+									Optional:     true,
+									ValidateFunc: validateScopeExpression,
+								},
+								"variables": {
+									Type:        schema.TypeList,
+									Description: "List of variables in the scope.",
+									Optional:    true,
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"attribute": {
+												Type:        schema.TypeString,
+												Description: "Variable attribute.",
+												Optional:    true,
+											},
+											"value": {
+												Type:        schema.TypeString,
+												Description: "Variable value.",
+												Optional:    true,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"exclude_application_scopes": {
+			Type:        schema.TypeList,
+			Description: "List of excluded application scopes.",
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Excluded application scope.",
+			},
+		},
+		"enabled": {
+			Type:        schema.TypeBool,
+			Description: "Indicates if the runtime policy is enabled or not.",
+			Default:     true,
+			Optional:    true,
+		},
+		"enforce": {
+			Type:        schema.TypeBool,
+			Description: "Indicates that policy should effect container execution (not just for audit).",
+			Default:     false,
+			Optional:    true,
+		},
+		"enforce_after_days": {
+			Type:        schema.TypeInt,
+			Description: "Indicates the number of days after which the runtime policy will be changed to enforce mode.",
+			Optional:    true,
+		},
+		"default_security_profile": {
+			Type:         schema.TypeString,
+			Description:  "Default security profile of the workloads, one of `RuntimeDefault`, `Unconfined` or `Localhost/<profile>`.",
+			Optional:     true,
+			ValidateFunc: validateRuntimePolicySecurityProfile,
+		},
+		"registry": {
+			Type:        schema.TypeString,
+			Description: "",
+			Optional:    true,
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Description: "",
+			Optional:    true,
+			Default:     "runtime.policy",
+		},
+		"digest": {
+			Type:        schema.TypeString,
+			Description: "",
+			Optional:    true,
+		},
+		"resource_name": {
+			Type:        schema.TypeString,
+			Description: "",
+			Optional:    true,
+		},
+		"resource_type": {
+			Type:        schema.TypeString,
+			Description: "",
+			Optional:    true,
+		},
+		"cve": {
+			Type:        schema.TypeString,
+			Description: "",
+			Optional:    true,
+		},
+		"repo_name": {
+			Type:        schema.TypeString,
+			Description: "",
+			Optional:    true,
+		},
+		"image_name": {
+			Type:        schema.TypeString,
+			Description: "",
+			Optional:    true,
+		},
+		"permission": {
+			Type:        schema.TypeString,
+			Description: "",
+			Optional:    true,
+			Default:     "Write",
+		},
+		"is_audit_checked": {
+			Type:        schema.TypeBool,
+			Description: "",
+			Optional:    true,
+		},
+		"is_ootb_policy": {
+			Type:        schema.TypeBool,
+			Description: "",
+			Optional:    true,
+		},
+		"is_auto_generated": {
+			Type:        schema.TypeBool,
+			Description: "",
+			Optional:    true,
+		},
+		"runtime_mode": {
+			Type:        schema.TypeInt,
+			Description: "",
+			Optional:    true,
+		},
+		"runtime_type": {
+			Type:        schema.TypeString,
+			Description: "",
+			Optional:    true,
+			Default:     runtimeType,
+		},
+	}
+}
+
+// runtimePolicyBoolControl is a control made of a single boolean attribute of the runtime policy
+func runtimePolicyBoolControl(attribute, description string, field func(crp *client.RuntimePolicy) *bool) runtimePolicyControl {
+	return runtimePolicyControl{
+		schema: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				attribute: {
+					Type:        schema.TypeBool,
+					Description: description,
+					Optional:    true,
+				},
+			}
+		},
+		expand: func(d *schema.ResourceData, crp *client.RuntimePolicy) {
+			v, ok := d.GetOk(attribute)
+			if ok {
+				*field(crp) = v.(bool)
+			}
+		},
+		flatten: func(d *schema.ResourceData, crp *client.RuntimePolicy) {
+			d.Set(attribute, *field(crp))
+		},
+	}
+}
+
+func runtimePolicyForkGuardSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"enable_fork_guard": {
+			Type:        schema.TypeBool,
+			Description: "If true, fork bombs are prevented in the containers.",
+			Optional:    true,
+		},
+		"fork_guard_process_limit": {
+			Type:        schema.TypeInt,
+			Description: "Process limit for the fork guard.",
+			Optional:    true,
+		},
+	}
+}
+
+func expandRuntimePolicyForkGuard(d *schema.ResourceData, crp *client.RuntimePolicy) {
+	enableForkGuard, ok := d.GetOk("enable_fork_guard")
+	if ok {
+		crp.EnableForkGuard = enableForkGuard.(bool)
+	}
+
+	forkGuardProcessLimit, ok := d.GetOk("fork_guard_process_limit")
+	if ok {
+		crp.ForkGuardProcessLimit = forkGuardProcessLimit.(int)
+	}
+}
+
+func flattenRuntimePolicyForkGuard(d *schema.ResourceData, crp *client.RuntimePolicy) {
+	d.Set("enable_fork_guard", crp.EnableForkGuard)
+	d.Set("fork_guard_process_limit", crp.ForkGuardProcessLimit)
+}
+
+func runtimePolicyFileIntegrityMonitoringSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"file_integrity_monitoring": {
+			Type:        schema.TypeList,
+			Description: "Configuration for file integrity monitoring.",
+			Optional:    true,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:        schema.TypeBool,
+						Description: "If true, file integrity monitoring is enabled.",
+						Optional:    true,
+					},
+					"monitored_files": {
+						Type:        schema.TypeList,
+						Description: "List of paths to be monitored.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+					"exceptional_monitored_files": {
+						Type:        schema.TypeList,
+						Description: "List of paths to be excluded from monitoring.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+					"monitored_files_read": {
+						Type:        schema.TypeBool,
+						Description: "Whether to monitor file read operations.",
+						Optional:    true,
+					},
+					"monitored_files_modify": {
+						Type:        schema.TypeBool,
+						Description: "Whether to monitor file modify operations.",
+						Optional:    true,
+					},
+					"monitored_files_attributes": {
+						Type:        schema.TypeBool,
+						Description: "Whether to monitor file attribute operations.",
+						Optional:    true,
+					},
+					"monitored_files_create": {
+						Type:        schema.TypeBool,
+						Description: "Whether to monitor file create operations.",
+						Optional:    true,
+					},
+					"monitored_files_delete": {
+						Type:        schema.TypeBool,
+						Description: "Whether to monitor file delete operations.",
+						Optional:    true,
+					},
+					"monitored_files_processes": {
+						Type:        schema.TypeList,
+						Description: "List of processes associated with monitored files.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+					"exceptional_monitored_files_processes": {
+						Type:        schema.TypeList,
+						Description: "List of processes to be excluded from monitoring.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+					"monitored_files_users": {
+						Type:        schema.TypeList,
+						Description: "List of users associated with monitored files.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+					"exceptional_monitored_files_users": {
+						Type:        schema.TypeList,
+						Description: "List of users to be excluded from monitoring.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandRuntimePolicyFileIntegrityMonitoring(d *schema.ResourceData, crp *client.RuntimePolicy) {
+	crp.FileIntegrityMonitoring = client.FileIntegrityMonitoring{}
+	fileIntegrityMonitoringMap, ok := d.GetOk("file_integrity_monitoring")
+	if ok {
+		v := fileIntegrityMonitoringMap.([]interface{})[0].(map[string]interface{})
+
+		crp.FileIntegrityMonitoring = client.FileIntegrityMonitoring{
+			Enabled:                            v["enabled"].(bool),
+			MonitoredFiles:                     convertStringArrNull(v["monitored_files"].([]interface{})),
+			ExceptionalMonitoredFiles:          convertStringArrNull(v["exceptional_monitored_files"].([]interface{})),
+			MonitoredFilesProcesses:            convertStringArrNull(v["monitored_files_processes"].([]interface{})),
+			ExceptionalMonitoredFilesProcesses: convertStringArrNull(v["exceptional_monitored_files_processes"].([]interface{})),
+			MonitoredFilesUsers:                convertStringArrNull(v["monitored_files_users"].([]interface{})),
+			ExceptionalMonitoredFilesUsers:     convertStringArrNull(v["exceptional_monitored_files_users"].([]interface{})),
+			MonitoredFilesCreate:               v["monitored_files_create"].(bool),
+			MonitoredFilesRead:                 v["monitored_files_read"].(bool),
+			MonitoredFilesModify:               v["monitored_files_modify"].(bool),
+			MonitoredFilesDelete:               v["monitored_files_delete"].(bool),
+			MonitoredFilesAttributes:           v["monitored_files_attributes"].(bool),
+		}
+	}
+}
+
+func runtimePolicyMalwareScanOptionsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"malware_scan_options": {
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Description: "Configuration for Real-Time Malware Protection.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:        schema.TypeBool,
+						Description: "Defines if enabled or not",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+					"action": {
+						Type:        schema.TypeString,
+						Description: "Set Action, Defaults to 'Alert' when empty. One of `alert` or `block`.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional:     true,
+						ValidateFunc: validateRuntimePolicyMalwareAction,
+					},
+					"include_directories": {
+						Type:        schema.TypeList,
+						Description: "List of registry paths to be excluded from being protected.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+					"exclude_directories": {
+						Type:        schema.TypeList,
+						Description: "List of registry paths to be excluded from being protected.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+					"exclude_processes": {
+						Type:        schema.TypeList,
+						Description: "List of registry processes to be excluded from being protected.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+				},
+			},
+			Optional: true,
+			Computed: true,
+		},
+	}
+}
+
+func expandRuntimePolicyMalwareScanOptions(d *schema.ResourceData, crp *client.RuntimePolicy) {
+	crp.MalwareScanOptions = client.MalwareScanOptions{}
+	malwareScanOptionsMap, ok := d.GetOk("malware_scan_options")
+	if ok {
+		v := malwareScanOptionsMap.([]interface{})[0].(map[string]interface{})
+
+		crp.MalwareScanOptions = client.MalwareScanOptions{
+			Enabled:            v["enabled"].(bool),
+			Action:             v["action"].(string),
+			ExcludeDirectories: convertStringArrNull(v["exclude_directories"].([]interface{})),
+			ExcludeProcesses:   convertStringArrNull(v["exclude_processes"].([]interface{})),
+			IncludeDirectories: convertStringArrNull(v["include_directories"].([]interface{})),
+		}
+	}
+}
+
+func runtimePolicyFailedKubernetesChecksSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"failed_kubernetes_checks": {
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Description: "",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+					},
+					"failed_checks": {
+						Type:        schema.TypeList,
+						Description: "",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+				},
+			},
+			Optional: true,
+			Computed: true,
+		},
+	}
+}
+
+func expandRuntimePolicyFailedKubernetesChecks(d *schema.ResourceData, crp *client.RuntimePolicy) {
+	crp.FailedKubernetesChecks = client.FailedKubernetesChecks{}
+	failedKubernetesChecksMap, ok := d.GetOk("failed_kubernetes_checks")
+	if ok {
+		v := failedKubernetesChecksMap.([]interface{})[0].(map[string]interface{})
+
+		crp.FailedKubernetesChecks = client.FailedKubernetesChecks{
+			Enabled:      v["enabled"].(bool),
+			FailedChecks: convertStringArr(v["failed_checks"].([]interface{})),
+		}
+	}
+}
+
+func runtimePolicyReverseShellSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"reverse_shell": {
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Description: "",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+					},
+					"block_reverse_shell": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+					},
+					"reverse_shell_proc_white_list": {
+						Type:        schema.TypeList,
+						Description: "",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+					"reverse_shell_ip_white_list": {
+						Type:        schema.TypeList,
+						Description: "IP addresses or CIDRs allowed as reverse shell destinations.",
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validateRuntimePolicyIPOrCIDR,
+						},
+						Optional: true,
+					},
+				},
+			},
+			Optional: true,
+			Computed: true,
+		},
+	}
+}
+
+func expandRuntimePolicyReverseShell(d *schema.ResourceData, crp *client.RuntimePolicy) {
+	crp.ReverseShell = client.ReverseShell{}
+	reverseShellMap, ok := d.GetOk("reverse_shell")
+	if ok {
+		v := reverseShellMap.([]interface{})[0].(map[string]interface{})
+
+		crp.ReverseShell = client.ReverseShell{
+			Enabled:                   v["enabled"].(bool),
+			BlockReverseShell:         v["block_reverse_shell"].(bool),
+			ReverseShellProcWhiteList: convertStringArrNull(v["reverse_shell_proc_white_list"].([]interface{})),
+			ReverseShellIpWhiteList:   convertStringArrNull(v["reverse_shell_ip_white_list"].([]interface{})),
+		}
+	}
+}
+
+func runtimePolicyContainerExecSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"container_exec": {
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Description: "",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+					},
+					"block_container_exec": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+					},
+					"container_exec_proc_white_list": {
+						Type:        schema.TypeList,
+						Description: "",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+					"reverse_shell_ip_white_list": {
+						Type:        schema.TypeList,
+						Description: "",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+				},
+			},
+			Optional: true,
+			Computed: true,
+		},
+	}
+}
+
+func expandRuntimePolicyContainerExec(d *schema.ResourceData, crp *client.RuntimePolicy) {
+	crp.ContainerExec = client.ContainerExec{}
+	containerExecMap, ok := d.GetOk("container_exec")
+	if ok {
+		v := containerExecMap.([]interface{})[0].(map[string]interface{})
+
+		crp.ContainerExec = client.ContainerExec{
+			Enabled:            v["enabled"].(bool),
+			BlockContainerExec: v["block_container_exec"].(bool),
+		}
+
+		// Check if "container_exec_proc_white_list" is provided and not an empty array
+		if whiteList, whiteListOk := v["container_exec_proc_white_list"]; whiteListOk && len(whiteList.([]interface{})) > 0 {
+			crp.ContainerExec.ContainerExecProcWhiteList = convertStringArr(whiteList.([]interface{}))
+		} else {
+			crp.ContainerExec.ContainerExecProcWhiteList = nil
+		}
+	}
+}
+
+func runtimePolicySystemIntegrityProtectionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"system_integrity_protection": {
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Description: "",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+					},
+					"audit_systemtime_change": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+					},
+					"windows_services_monitoring": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+					},
+					"monitor_audit_log_integrity": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+					},
+				},
+			},
+			Optional: true,
+			Computed: true,
+		},
+	}
+}
+
+func expandRuntimePolicySystemIntegrityProtection(d *schema.ResourceData, crp *client.RuntimePolicy) {
+	crp.SystemIntegrityProtection = client.SystemIntegrityProtection{}
+	systemIntegrityProtectionMap, ok := d.GetOk("system_integrity_protection")
+	if ok {
+		v := systemIntegrityProtectionMap.([]interface{})[0].(map[string]interface{})
+
+		crp.SystemIntegrityProtection = client.SystemIntegrityProtection{
+			Enabled:                   v["enabled"].(bool),
+			AuditSystemtimeChange:     v["audit_systemtime_change"].(bool),
+			WindowsServicesMonitoring: v["windows_services_monitoring"].(bool),
+			MonitorAuditLogIntegrity:  v["monitor_audit_log_integrity"].(bool),
+		}
+	}
+}
+
+func runtimePolicyReadonlyRegistrySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"readonly_registry": {
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Description: "",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+					},
+					"readonly_registry_paths": {
+						Type:        schema.TypeList,
+						Description: "",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+					"exceptional_readonly_registry_paths": {
+						Type:        schema.TypeList,
+						Description: "",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+					"readonly_registry_users": {
+						Type:        schema.TypeList,
+						Description: "",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+					"exceptional_readonly_registry_users": {
+						Type:        schema.TypeList,
+						Description: "",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+					"readonly_registry_processes": {
+						Type:        schema.TypeList,
+						Description: "",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+					"exceptional_readonly_registry_processes": {
+						Type:        schema.TypeList,
+						Description: "",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+				},
+			},
+			Optional: true,
+			Computed: true,
+		},
+	}
+}
+
+func expandRuntimePolicyReadonlyRegistry(d *schema.ResourceData, crp *client.RuntimePolicy) {
+	crp.ReadonlyRegistry = client.ReadonlyRegistry{}
+	readonlyRegistryMap, ok := d.GetOk("readonly_registry")
+	if ok {
+		v := readonlyRegistryMap.([]interface{})[0].(map[string]interface{})
+
+		crp.ReadonlyRegistry = client.ReadonlyRegistry{
+			Enabled:                              v["enabled"].(bool),
+			ReadonlyRegistryPaths:                convertStringArr(v["readonly_registry_paths"].([]interface{})),
+			ExceptionalReadonlyRegistryPaths:     convertStringArr(v["exceptional_readonly_registry_paths"].([]interface{})),
+			ReadonlyRegistryUsers:                convertStringArr(v["readonly_registry_users"].([]interface{})),
+			ExceptionalReadonlyRegistryUsers:     convertStringArr(v["exceptional_readonly_registry_users"].([]interface{})),
+			ReadonlyRegistryProcesses:            convertStringArr(v["readonly_registry_processes"].([]interface{})),
+			ExceptionalReadonlyRegistryProcesses: convertStringArr(v["exceptional_readonly_registry_processes"].([]interface{})),
+		}
+	}
+}
+
+func runtimePolicyRegistryAccessMonitoringSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"registry_access_monitoring": {
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Description: "",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+					},
+					"monitored_registry_paths": {
+						Type:        schema.TypeList,
+						Description: "",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+					"exceptional_monitored_registry_paths": {
+						Type:        schema.TypeList,
+						Description: "",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+					"monitored_registry_users": {
+						Type:        schema.TypeList,
+						Description: "",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+					"exceptional_monitored_registry_users": {
+						Type:        schema.TypeList,
+						Description: "",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+					"monitored_registry_processes": {
+						Type:        schema.TypeList,
+						Description: "",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+					"exceptional_monitored_registry_processes": {
+						Type:        schema.TypeList,
+						Description: "",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+					"monitored_registry_create": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+					},
+					"monitored_registry_read": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+					},
+					"monitored_registry_modify": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+					},
+					"monitored_registry_delete": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+					},
+					"monitored_registry_attributes": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+					},
+				},
+			},
+			Optional: true,
+			Computed: true,
+		},
+	}
+}
+
+func expandRuntimePolicyRegistryAccessMonitoring(d *schema.ResourceData, crp *client.RuntimePolicy) {
+	crp.RegistryAccessMonitoring = client.RegistryAccessMonitoring{}
+	registryAccessMonitoringMap, ok := d.GetOk("registry_access_monitoring")
+	if ok {
+		v := registryAccessMonitoringMap.([]interface{})[0].(map[string]interface{})
+
+		crp.RegistryAccessMonitoring = client.RegistryAccessMonitoring{
+			Enabled:                               v["enabled"].(bool),
+			MonitoredRegistryPaths:                convertStringArr(v["monitored_registry_paths"].([]interface{})),
+			ExceptionalMonitoredRegistryPaths:     convertStringArr(v["exceptional_monitored_registry_paths"].([]interface{})),
+			MonitoredRegistryUsers:                convertStringArr(v["monitored_registry_users"].([]interface{})),
+			ExceptionalMonitoredRegistryUsers:     convertStringArr(v["exceptional_monitored_registry_users"].([]interface{})),
+			MonitoredRegistryProcesses:            convertStringArr(v["monitored_registry_processes"].([]interface{})),
+			ExceptionalMonitoredRegistryProcesses: convertStringArr(v["exceptional_monitored_registry_processes"].([]interface{})),
+			MonitoredRegistryCreate:               v["monitored_registry_create"].(bool),
+			MonitoredRegistryRead:                 v["monitored_registry_read"].(bool),
+			MonitoredRegistryModify:               v["monitored_registry_modify"].(bool),
+			MonitoredRegistryDelete:               v["monitored_registry_delete"].(bool),
+			MonitoredRegistryAttributes:           v["monitored_registry_attributes"].(bool),
+		}
+	}
+}
+
+func runtimePolicyReadonlyFilesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"readonly_files": {
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Description: "",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+					},
+					"readonly_files": {
+						Type:        schema.TypeList,
+						Description: "",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+					"exceptional_readonly_files": {
+						Type:        schema.TypeList,
+						Description: "",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+					"readonly_files_processes": {
+						Type:        schema.TypeList,
+						Description: "",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+					"exceptional_readonly_files_processes": {
+						Type:        schema.TypeList,
+						Description: "",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+					"readonly_files_users": {
+						Type:        schema.TypeList,
+						Description: "",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+					"exceptional_readonly_files_users": {
+						Type:        schema.TypeList,
+						Description: "",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+				},
+			},
+			Optional: true,
+			Computed: true,
+		},
+	}
+}
+
+func expandRuntimePolicyReadonlyFiles(d *schema.ResourceData, crp *client.RuntimePolicy) {
+	crp.ReadonlyFiles = client.ReadonlyFiles{}
+	readonlyFilesMap, ok := d.GetOk("readonly_files")
+	if ok {
+		v := readonlyFilesMap.([]interface{})[0].(map[string]interface{})
+
+		crp.ReadonlyFiles = client.ReadonlyFiles{
+			Enabled:                           v["enabled"].(bool),
+			ReadonlyFiles:                     convertStringArr(v["readonly_files"].([]interface{})),
+			ExceptionalReadonlyFiles:          convertStringArr(v["exceptional_readonly_files"].([]interface{})),
+			ReadonlyFilesProcesses:            convertStringArr(v["readonly_files_processes"].([]interface{})),
+			ExceptionalReadonlyFilesProcesses: convertStringArr(v["exceptional_readonly_files_processes"].([]interface{})),
+			ReadonlyFilesUsers:                convertStringArr(v["readonly_files_users"].([]interface{})),
+			ExceptionalReadonlyFilesUsers:     convertStringArr(v["exceptional_readonly_files_users"].([]interface{})),
+		}
+	}
+}
+
+func runtimePolicyTripwireSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"tripwire": {
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Description: "",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+					},
+					"user_id": {
+						Type:        schema.TypeString,
+						Description: "",
+						Optional:    true,
+					},
+					"user_password": {
+						Type:        schema.TypeString,
+						Description: "",
+						Optional:    true,
+					},
+					"apply_on": {
+						Type:        schema.TypeList,
+						Description: "Where the honeypot is applied, any of `Environment Variable`, `Layer`, `File` or `Function`.",
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validateRuntimePolicyTripwireApplyOn,
+						},
+						Optional: true,
+					},
+					"serverless_app": {
+						Type:        schema.TypeString,
+						Description: "",
+						Optional:    true,
+					},
+				},
+			},
+			Optional: true,
+			Computed: true,
+		},
+	}
+}
+
+func expandRuntimePolicyTripwire(d *schema.ResourceData, crp *client.RuntimePolicy) {
+	crp.Tripwire = client.Tripwire{}
+	tripwireMap, ok := d.GetOk("tripwire")
+	if ok {
+		v := tripwireMap.([]interface{})[0].(map[string]interface{})
+
+		crp.Tripwire = client.Tripwire{
+			Enabled:       v["enabled"].(bool),
+			UserID:        v["user_id"].(string),
+			UserPassword:  v["user_password"].(string),
+			ApplyOn:       convertStringArrNull(v["apply_on"].([]interface{})),
+			ServerlessApp: v["serverless_app"].(string),
+		}
+	}
+}
+
+func runtimePolicyPortBlockSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"port_block": {
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Description: "",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+					},
+					"block_inbound_ports": {
+						Type:        schema.TypeList,
+						Description: "Inbound ports or port ranges to block, e.g. `22` or `1-1024`.",
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validateRuntimePolicyPort,
+						},
+						Optional: true,
+					},
+					"block_outbound_ports": {
+						Type:        schema.TypeList,
+						Description: "Outbound ports or port ranges to block, e.g. `22` or `1-1024`.",
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validateRuntimePolicyPort,
+						},
+						Optional: true,
+					},
+				},
+			},
+			Optional: true,
+			Computed: true,
+		},
+	}
+}
+
+func expandRuntimePolicyPortBlock(d *schema.ResourceData, crp *client.RuntimePolicy) {
+	crp.PortBlock = client.PortBlock{}
+	portBlockMap, ok := d.GetOk("port_block")
+	if ok {
+		v := portBlockMap.([]interface{})[0].(map[string]interface{})
+
+		crp.PortBlock = client.PortBlock{
+			Enabled:            v["enabled"].(bool),
+			BlockInboundPorts:  convertStringArr(v["block_inbound_ports"].([]interface{})),
+			BlockOutboundPorts: convertStringArr(v["block_outbound_ports"].([]interface{})),
+		}
+	}
+}
+
+func runtimePolicyLinuxCapabilitiesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"linux_capabilities": {
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Description: "",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+					},
+					"remove_linux_capabilities": {
+						Type:        schema.TypeList,
+						Description: "Linux capabilities to remove, e.g. `NET_RAW`. The names are case insensitive and the `CAP_` prefix is optional.",
+						Elem: &schema.Schema{
+							Type:             schema.TypeString,
+							ValidateFunc:     validateRuntimePolicyLinuxCapability,
+							DiffSuppressFunc: suppressEquivalentLinuxCapabilityDiff,
+						},
+						Optional: true,
+					},
+				},
+			},
+			Optional: true,
+			Computed: true,
+		},
+	}
+}
+
+func expandRuntimePolicyLinuxCapabilities(d *schema.ResourceData, crp *client.RuntimePolicy) {
+	crp.LinuxCapabilities = client.LinuxCapabilities{}
+	linuxCapabilitiesMap, ok := d.GetOk("linux_capabilities")
+	if ok {
+		v := linuxCapabilitiesMap.([]interface{})[0].(map[string]interface{})
+
+		crp.LinuxCapabilities = client.LinuxCapabilities{
+			Enabled:                 v["enabled"].(bool),
+			RemoveLinuxCapabilities: normalizeRuntimePolicyLinuxCapabilities(convertStringArr(v["remove_linux_capabilities"].([]interface{}))),
+		}
+	}
+}
+
+func runtimePolicyPackageBlockSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"package_block": {
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Description: "",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+					},
+					"packages_black_list": {
+						Type:        schema.TypeList,
+						Description: "",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+					"exceptional_block_packages_files": {
+						Type:        schema.TypeList,
+						Description: "",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+					"block_packages_users": {
+						Type:        schema.TypeList,
+						Description: "",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+					"block_packages_processes": {
+						Type:        schema.TypeList,
+						Description: "",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+					"exceptional_block_packages_users": {
+						Type:        schema.TypeList,
+						Description: "",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+					"exceptional_block_packages_processes": {
+						Type:        schema.TypeList,
+						Description: "",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+				},
+			},
+			Optional: true,
+			Computed: true,
+		},
+	}
+}
+
+func expandRuntimePolicyPackageBlock(d *schema.ResourceData, crp *client.RuntimePolicy) {
+	crp.PackageBlock = client.PackageBlock{}
+	packageBlockMap, ok := d.GetOk("package_block")
+	if ok {
+		v := packageBlockMap.([]interface{})[0].(map[string]interface{})
+
+		crp.PackageBlock = client.PackageBlock{
+			Enabled:                           v["enabled"].(bool),
+			PackagesBlackList:                 convertStringArr(v["packages_black_list"].([]interface{})),
+			ExceptionalBlockPackagesFiles:     convertStringArr(v["exceptional_block_packages_files"].([]interface{})),
+			BlockPackagesUsers:                convertStringArr(v["block_packages_users"].([]interface{})),
+			BlockPackagesProcesses:            convertStringArr(v["block_packages_processes"].([]interface{})),
+			ExceptionalBlockPackagesUsers:     convertStringArr(v["exceptional_block_packages_users"].([]interface{})),
+			ExceptionalBlockPackagesProcesses: convertStringArr(v["exceptional_block_packages_processes"].([]interface{})),
+		}
+	}
+}
+
+func runtimePolicyFileBlockSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"file_block": {
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Description: "",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+					},
+					"filename_block_list": {
+						Type:        schema.TypeList,
+						Description: "",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+					"exceptional_block_files": {
+						Type:        schema.TypeList,
+						Description: "",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+					"block_files_users": {
+						Type:        schema.TypeList,
+						Description: "",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+					"block_files_processes": {
+						Type:        schema.TypeList,
+						Description: "",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+					"exceptional_block_files_users": {
+						Type:        schema.TypeList,
+						Description: "",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+					"exceptional_block_files_processes": {
+						Type:        schema.TypeList,
+						Description: "",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+				},
+			},
+			Optional: true,
+			Computed: true,
+		},
+	}
+}
+
+func expandRuntimePolicyFileBlock(d *schema.ResourceData, crp *client.RuntimePolicy) {
+	crp.FileBlock = client.FileBlock{}
+	fileBlockMap, ok := d.GetOk("file_block")
+	if ok {
+		v := fileBlockMap.([]interface{})[0].(map[string]interface{})
+
+		crp.FileBlock = client.FileBlock{
+			Enabled:                        v["enabled"].(bool),
+			FilenameBlockList:              convertStringArr(v["filename_block_list"].([]interface{})),
+			ExceptionalBlockFiles:          convertStringArr(v["exceptional_block_files"].([]interface{})),
+			BlockFilesUsers:                convertStringArr(v["block_files_users"].([]interface{})),
+			BlockFilesProcesses:            convertStringArr(v["block_files_processes"].([]interface{})),
+			ExceptionalBlockFilesUsers:     convertStringArr(v["exceptional_block_files_users"].([]interface{})),
+			ExceptionalBlockFilesProcesses: convertStringArr(v["exceptional_block_files_processes"].([]interface{})),
+		}
+	}
+}
+
+func runtimePolicyWhitelistedOsUsersSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"whitelisted_os_users": {
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Description: "",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+					},
+					"user_white_list": {
+						Type:        schema.TypeList,
+						Description: "",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+					"group_white_list": {
+						Type:        schema.TypeList,
+						Description: "",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+				},
+			},
+			Optional: true,
+			Computed: true,
+		},
+	}
+}
+
+func expandRuntimePolicyWhitelistedOsUsers(d *schema.ResourceData, crp *client.RuntimePolicy) {
+	crp.WhitelistedOsUsers = client.WhitelistedOsUsers{}
+	whitelistedOSUsersMap, ok := d.GetOk("whitelisted_os_users")
+	if ok {
+		v := whitelistedOSUsersMap.([]interface{})[0].(map[string]interface{})
+
+		crp.WhitelistedOsUsers = client.WhitelistedOsUsers{
+			Enabled:        v["enabled"].(bool),
+			UserWhiteList:  convertStringArr(v["user_white_list"].([]interface{})),
+			GroupWhiteList: convertStringArr(v["group_white_list"].([]interface{})),
+		}
+	}
+}
+
+func runtimePolicyBlacklistedOsUsersSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"blacklisted_os_users": {
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Description: "",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+					},
+					"group_black_list": {
+						Type:        schema.TypeList,
+						Description: "",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+					"user_black_list": {
+						Type:        schema.TypeList,
+						Description: "",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+				},
+			},
+			Optional: true,
+			Computed: true,
+		},
+	}
+}
+
+func expandRuntimePolicyBlacklistedOsUsers(d *schema.ResourceData, crp *client.RuntimePolicy) {
+	crp.BlacklistedOsUsers = client.BlacklistedOsUsers{}
+	blacklistedOSUsersMap, ok := d.GetOk("blacklisted_os_users")
+	if ok {
+		v := blacklistedOSUsersMap.([]interface{})[0].(map[string]interface{})
+
+		crp.BlacklistedOsUsers = client.BlacklistedOsUsers{
+			Enabled:        v["enabled"].(bool),
+			GroupBlackList: convertStringArr(v["group_black_list"].([]interface{})),
+			UserBlackList:  convertStringArr(v["user_black_list"].([]interface{})),
+		}
+	}
+}
+
+func runtimePolicyAuditingSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"auditing": {
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Description: "",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+					},
+					"audit_all_processes": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+					},
+					"audit_process_cmdline": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+					},
+					"audit_all_network": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+					},
+					"audit_os_user_activity": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+					},
+					"audit_success_login": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+					},
+					"audit_failed_login": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+					},
+					"audit_user_account_management": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+					},
+				},
+			},
+			Optional: true,
+			Computed: true,
+		},
+	}
+}
+
+func expandRuntimePolicyAuditing(d *schema.ResourceData, crp *client.RuntimePolicy) {
+	crp.Auditing = client.Auditing{}
+	auditingMap, ok := d.GetOk("auditing")
+	if ok {
+		v := auditingMap.([]interface{})[0].(map[string]interface{})
+
+		crp.Auditing = client.Auditing{
+			Enabled:                    v["enabled"].(bool),
+			AuditAllProcesses:          v["audit_all_processes"].(bool),
+			AuditProcessCmdline:        v["audit_process_cmdline"].(bool),
+			AuditAllNetwork:            v["audit_all_network"].(bool),
+			AuditOsUserActivity:        v["audit_os_user_activity"].(bool),
+			AuditSuccessLogin:          v["audit_success_login"].(bool),
+			AuditFailedLogin:           v["audit_failed_login"].(bool),
+			AuditUserAccountManagement: v["audit_user_account_management"].(bool),
+		}
+	}
+}
+
+func runtimePolicyLimitContainerPrivilegesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"limit_container_privileges": {
+			Type:        schema.TypeList,
+			Description: "Container privileges configuration.",
+			Optional:    true,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:        schema.TypeBool,
+						Description: "Whether container privilege limitations are enabled.",
+						Optional:    true,
+					},
+					"privileged": {
+						Type:        schema.TypeBool,
+						Description: "Whether the container is run in privileged mode.",
+						Optional:    true,
+					},
+					"netmode": {
+						Type:        schema.TypeBool,
+						Description: "Whether to limit network-related capabilities.",
+						Optional:    true,
+					},
+					"pidmode": {
+						Type:        schema.TypeBool,
+						Description: "Whether to limit process-related capabilities.",
+						Optional:    true,
+					},
+					"utsmode": {
+						Type:        schema.TypeBool,
+						Description: "Whether to limit UTS-related capabilities.",
+						Optional:    true,
+					},
+					"usermode": {
+						Type:        schema.TypeBool,
+						Description: "Whether to limit user-related capabilities.",
+						Optional:    true,
+					},
+					"ipcmode": {
+						Type:        schema.TypeBool,
+						Description: "Whether to limit IPC-related capabilities.",
+						Optional:    true,
+					},
+					"prevent_root_user": {
+						Type:        schema.TypeBool,
+						Description: "Whether to prevent the use of the root user.",
+						Optional:    true,
+					},
+					"prevent_low_port_binding": {
+						Type:        schema.TypeBool,
+						Description: "Whether to prevent low port binding.",
+						Optional:    true,
+					},
+					"block_add_capabilities": {
+						Type:        schema.TypeBool,
+						Description: "Whether to block adding capabilities.",
+						Optional:    true,
+					},
+					"use_host_user": {
+						Type:        schema.TypeBool,
+						Description: "Whether to use the host user.",
+						Optional:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandRuntimePolicyLimitContainerPrivileges(d *schema.ResourceData, crp *client.RuntimePolicy) {
+	crp.LimitContainerPrivileges = client.LimitContainerPrivileges{}
+	limitContainerPrivilegesMap, ok := d.GetOk("limit_container_privileges")
+	if ok {
+		v := limitContainerPrivilegesMap.([]interface{})[0].(map[string]interface{})
+
+		crp.LimitContainerPrivileges = client.LimitContainerPrivileges{
+			Enabled:               v["enabled"].(bool),
+			Privileged:            v["privileged"].(bool),
+			Netmode:               v["netmode"].(bool),
+			Pidmode:               v["pidmode"].(bool),
+			Utsmode:               v["utsmode"].(bool),
+			Usermode:              v["usermode"].(bool),
+			Ipcmode:               v["ipcmode"].(bool),
+			PreventRootUser:       v["prevent_root_user"].(bool),
+			PreventLowPortBinding: v["prevent_low_port_binding"].(bool),
+			BlockAddCapabilities:  v["block_add_capabilities"].(bool),
+			UseHostUser:           v["use_host_user"].(bool),
+		}
+	}
+}
+
+func runtimePolicyRestrictedVolumesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"restricted_volumes": {
+			Type:        schema.TypeList,
+			Description: "Restricted volumes configuration.",
+			Optional:    true,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:        schema.TypeBool,
+						Description: "Whether restricted volumes are enabled.",
+						Optional:    true,
+					},
+					"volumes": {
+						Type:        schema.TypeList,
+						Description: "List of restricted volumes.",
+						Optional:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandRuntimePolicyRestrictedVolumes(d *schema.ResourceData, crp *client.RuntimePolicy) {
+	crp.RestrictedVolumes = client.RestrictedVolumes{}
+	restrictedVolumesMap, ok := d.GetOk("restricted_volumes")
+	if ok {
+		v := restrictedVolumesMap.([]interface{})[0].(map[string]interface{})
+
+		crp.RestrictedVolumes = client.RestrictedVolumes{
+			Enabled: v["enabled"].(bool),
+			Volumes: convertStringArr(v["volumes"].([]interface{})),
+		}
+	}
+}
+
+func runtimePolicyExecutableBlacklistSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"executable_blacklist": {
+			Type:        schema.TypeList,
+			Description: "Executable blacklist configuration.",
+			Optional:    true,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:        schema.TypeBool,
+						Description: "Whether the executable blacklist is enabled.",
+						Optional:    true,
+					},
+					"executables": {
+						Type:        schema.TypeList,
+						Description: "List of blacklisted executables.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandRuntimePolicyExecutableBlacklist(d *schema.ResourceData, crp *client.RuntimePolicy) {
+	crp.ExecutableBlacklist = client.ExecutableBlacklist{}
+	executableBlacklistMap, ok := d.GetOk("executable_blacklist")
+	if ok {
+		v := executableBlacklistMap.([]interface{})[0].(map[string]interface{})
+
+		crp.ExecutableBlacklist = client.ExecutableBlacklist{
+			Enabled:     v["enabled"].(bool),
+			Executables: convertStringArrNull(v["executables"].([]interface{})),
+		}
+	}
+}
+
+func runtimePolicyDriftPreventionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"drift_prevention": {
+			Type:        schema.TypeList,
+			Description: "Drift prevention configuration.",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:        schema.TypeBool,
+						Description: "Whether drift prevention is enabled.",
+						Optional:    true,
+					},
+					"exec_lockdown": {
+						Type:        schema.TypeBool,
+						Description: "Whether to lockdown execution drift.",
+						Optional:    true,
+					},
+					"image_lockdown": {
+						Type:        schema.TypeBool,
+						Description: "Whether to lockdown image drift.",
+						Optional:    true,
+					},
+					"exec_lockdown_white_list": {
+						Type:        schema.TypeList,
+						Description: "List of items in the execution lockdown white list.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+				},
+			},
+			Computed: true,
+		},
+	}
+}
+
+func expandRuntimePolicyDriftPrevention(d *schema.ResourceData, crp *client.RuntimePolicy) {
+	crp.DriftPrevention = client.DriftPrevention{}
+	driftPreventionMap, ok := d.GetOk("drift_prevention")
+	if ok {
+		v := driftPreventionMap.([]interface{})[0].(map[string]interface{})
+
+		crp.DriftPrevention = client.DriftPrevention{
+			Enabled:               v["enabled"].(bool),
+			ExecLockdown:          v["exec_lockdown"].(bool),
+			ImageLockdown:         v["image_lockdown"].(bool),
+			ExecLockdownWhiteList: convertStringArrNull(v["exec_lockdown_white_list"].([]interface{})),
+		}
+	}
+}
+
+func runtimePolicyAllowedRegistriesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"allowed_registries": {
+			Type:        schema.TypeList,
+			Description: "Allowed registries configuration.",
+			Optional:    true,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:        schema.TypeBool,
+						Description: "Whether allowed registries are enabled.",
+						Optional:    true,
+					},
+					"allowed_registries": {
+						Type:        schema.TypeList,
+						Description: "List of allowed registries.",
+						Optional:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandRuntimePolicyAllowedRegistries(d *schema.ResourceData, crp *client.RuntimePolicy) {
+	crp.AllowedRegistries = client.AllowedRegistries{}
+	allowedRegistriesMap, ok := d.GetOk("allowed_registries")
+	if ok {
+		v := allowedRegistriesMap.([]interface{})[0].(map[string]interface{})
+
+		crp.AllowedRegistries = client.AllowedRegistries{
+			Enabled:           v["enabled"].(bool),
+			AllowedRegistries: convertStringArr(v["allowed_registries"].([]interface{})),
+		}
+	}
+}
+
+func runtimePolicyAllowedExecutablesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"allowed_executables": {
+			Type:        schema.TypeList,
+			Description: "Allowed executables configuration.",
+			Optional:    true,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:        schema.TypeBool,
+						Description: "Whether allowed executables configuration is enabled.",
+						Optional:    true,
+					},
+					"allow_executables": {
+						Type:        schema.TypeList,
+						Description: "List of allowed executables.",
+						Optional:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"separate_executables": {
+						Type:        schema.TypeBool,
+						Description: "Whether to treat executables separately.",
+						Optional:    true,
+					},
+					"allow_root_executables": {
+						Type:        schema.TypeList,
+						Description: "List of allowed root executables.",
+						Optional:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandRuntimePolicyAllowedExecutables(d *schema.ResourceData, crp *client.RuntimePolicy) {
+	crp.AllowedExecutables = client.AllowedExecutables{}
+	allowedExecutablesMap, ok := d.GetOk("allowed_executables")
+	if ok {
+		v := allowedExecutablesMap.([]interface{})[0].(map[string]interface{})
+
+		crp.AllowedExecutables = client.AllowedExecutables{
+			Enabled:              v["enabled"].(bool),
+			AllowExecutables:     convertStringArrNull(v["allow_executables"].([]interface{})),
+			SeparateExecutables:  v["separate_executables"].(bool),
+			AllowRootExecutables: convertStringArrNull(v["allow_root_executables"].([]interface{})),
+		}
+	}
+}
+
+func flattenBypassScope(bypassScope client.BypassScope) []map[string]interface{} {
+	if !bypassScope.Enabled && bypassScope.Scope.Expression == "" {
+		return []map[string]interface{}{}
+	}
+
+	variables := make([]map[string]interface{}, len(bypassScope.Scope.Variables))
+	for i, variable := range bypassScope.Scope.Variables {
+		variables[i] = map[string]interface{}{
+			"attribute": variable.Attribute,
+			"value":     variable.Value,
+		}
+	}
+
+	return []map[string]interface{}{
+		{
+			"enabled": bypassScope.Enabled,
+			"scope": []map[string]interface{}{
+				{
+					"expression": bypassScope.Scope.Expression,
+					"variables":  variables,
+				},
+			},
+		},
+	}
+}
+
+func flattenFileIntegrityMonitoring(monitoring client.FileIntegrityMonitoring) []map[string]interface{} {
+	//if len(monitoring.MonitoredFiles) == 0 {
+	//	return []map[string]interface{}{}
+	//}
+	return []map[string]interface{}{
+		{
+			"enabled":                               monitoring.Enabled,
+			"monitored_files_create":                monitoring.MonitoredFilesCreate,
+			"monitored_files_read":                  monitoring.MonitoredFilesRead,
+			"monitored_files_modify":                monitoring.MonitoredFilesModify,
+			"monitored_files_delete":                monitoring.MonitoredFilesDelete,
+			"monitored_files_attributes":            monitoring.MonitoredFilesAttributes,
+			"monitored_files":                       monitoring.MonitoredFiles,
+			"exceptional_monitored_files":           monitoring.ExceptionalMonitoredFiles,
+			"monitored_files_processes":             monitoring.MonitoredFilesProcesses,
+			"exceptional_monitored_files_processes": monitoring.ExceptionalMonitoredFilesProcesses,
+			"monitored_files_users":                 monitoring.MonitoredFilesUsers,
+			"exceptional_monitored_files_users":     monitoring.ExceptionalMonitoredFilesUsers,
+		},
+	}
+}
+
+func flattenWindowsRegistryProtection(monitoring client.ReadonlyRegistry) []map[string]interface{} {
+	//if len(monitoring.ReadonlyRegistryPaths) == 0 {
+	//	return []map[string]interface{}{}
+	//}
+	return []map[string]interface{}{
+		{
+			"protected_paths":     monitoring.ReadonlyRegistryPaths,
+			"excluded_paths":      monitoring.ExceptionalReadonlyRegistryPaths,
+			"protected_processes": monitoring.ReadonlyRegistryProcesses,
+			"excluded_processes":  monitoring.ExceptionalReadonlyRegistryProcesses,
+			"protected_users":     monitoring.ReadonlyRegistryUsers,
+			"excluded_users":      monitoring.ExceptionalReadonlyRegistryUsers,
+		},
+	}
+}
+
+func flattenWindowsRegistryMonitoring(monitoring client.RegistryAccessMonitoring) []map[string]interface{} {
+	//if len(monitoring.MonitoredRegistryPaths) == 0 {
+	//	return []map[string]interface{}{}
+	//}
+	return []map[string]interface{}{
+		{
+			"monitor_create":      monitoring.MonitoredRegistryCreate,
+			"monitor_read":        monitoring.MonitoredRegistryRead,
+			"monitor_modify":      monitoring.MonitoredRegistryModify,
+			"monitor_delete":      monitoring.MonitoredRegistryDelete,
+			"monitor_attributes":  monitoring.MonitoredRegistryAttributes,
+			"monitored_paths":     monitoring.MonitoredRegistryPaths,
+			"excluded_paths":      monitoring.ExceptionalMonitoredRegistryPaths,
+			"monitored_processes": monitoring.MonitoredRegistryProcesses,
+			"excluded_processes":  monitoring.ExceptionalMonitoredRegistryProcesses,
+			"monitored_users":     monitoring.MonitoredRegistryUsers,
+			"excluded_users":      monitoring.ExceptionalMonitoredRegistryUsers,
+		},
+	}
+}
+
+func flattenMalwareScanOptions(monitoring client.MalwareScanOptions) []map[string]interface{} {
+	//if len(monitoring.ExcludeDirectories) == 0 {
+	//	return []map[string]interface{}{}
+	//}
+	return []map[string]interface{}{
+		{
+			"enabled":             monitoring.Enabled,
+			"action":              monitoring.Action,
+			"exclude_directories": monitoring.ExcludeDirectories,
+			"exclude_processes":   monitoring.ExcludeProcesses,
+			"include_directories": monitoring.IncludeDirectories,
+		},
+	}
+}
+
+// JSON
+
+func flattenFailedKubernetesChecks(checks client.FailedKubernetesChecks) []map[string]interface{} {
+	//if len(checks.FailedChecks) == 0 {
+	//	return []map[string]interface{}{}
+	//}
+	return []map[string]interface{}{
+		{
+			"enabled":       checks.Enabled,
+			"failed_checks": checks.FailedChecks,
+		},
+	}
+}
+
+func flattenReverseShell(shell client.ReverseShell) []map[string]interface{} {
+	//if !shell.Enabled {
+	//	return []map[string]interface{}{}
+	//}
+	return []map[string]interface{}{
+		{
+			"enabled":                       shell.Enabled,
+			"block_reverse_shell":           shell.BlockReverseShell,
+			"reverse_shell_proc_white_list": shell.ReverseShellProcWhiteList,
+			"reverse_shell_ip_white_list":   shell.ReverseShellIpWhiteList,
+		},
+	}
+}
+
+func flattenContainerExec(exec client.ContainerExec) []map[string]interface{} {
+	//if !exec.Enabled {
+	//	return []map[string]interface{}{}
+	//}
+	return []map[string]interface{}{
+		{
+			"enabled":                        exec.Enabled,
+			"block_container_exec":           exec.BlockContainerExec,
+			"container_exec_proc_white_list": exec.ContainerExecProcWhiteList,
+		},
+	}
+}
+
+func flattenSystemIntegrityProtection(protection client.SystemIntegrityProtection) []map[string]interface{} {
+	//if !protection.Enabled {
+	//	return []map[string]interface{}{}
+	//}
+	return []map[string]interface{}{
+		{
+			"enabled":                     protection.Enabled,
+			"audit_systemtime_change":     protection.AuditSystemtimeChange,
+			"windows_services_monitoring": protection.WindowsServicesMonitoring,
+			"monitor_audit_log_integrity": protection.MonitorAuditLogIntegrity,
+		},
+	}
+}
+
+func flattenReadonlyRegistry(registry client.ReadonlyRegistry) []map[string]interface{} {
+	//if !registry.Enabled {
+	//	return []map[string]interface{}{}
+	//}
+	return []map[string]interface{}{
+		{
+			"enabled":                                 registry.Enabled,
+			"readonly_registry_paths":                 registry.ReadonlyRegistryPaths,
+			"exceptional_readonly_registry_paths":     registry.ExceptionalReadonlyRegistryPaths,
+			"readonly_registry_users":                 registry.ReadonlyRegistryUsers,
+			"exceptional_readonly_registry_users":     registry.ExceptionalReadonlyRegistryUsers,
+			"readonly_registry_processes":             registry.ReadonlyRegistryProcesses,
+			"exceptional_readonly_registry_processes": registry.ExceptionalReadonlyRegistryProcesses,
+		},
+	}
+}
+
+func flattenRegistryAccessMonitoring(monitoring client.RegistryAccessMonitoring) []map[string]interface{} {
+	//if !monitoring.Enabled {
+	//	return []map[string]interface{}{}
+	//}
+	return []map[string]interface{}{
+		{
+			"enabled":                                  monitoring.Enabled,
+			"monitored_registry_paths":                 monitoring.MonitoredRegistryPaths,
+			"exceptional_monitored_registry_paths":     monitoring.ExceptionalMonitoredRegistryPaths,
+			"monitored_registry_users":                 monitoring.MonitoredRegistryUsers,
+			"exceptional_monitored_registry_users":     monitoring.ExceptionalMonitoredRegistryUsers,
+			"monitored_registry_processes":             monitoring.MonitoredRegistryProcesses,
+			"exceptional_monitored_registry_processes": monitoring.ExceptionalMonitoredRegistryProcesses,
+			"monitored_registry_create":                monitoring.MonitoredRegistryCreate,
+			"monitored_registry_read":                  monitoring.MonitoredRegistryRead,
+			"monitored_registry_modify":                monitoring.MonitoredRegistryModify,
+			"monitored_registry_delete":                monitoring.MonitoredRegistryDelete,
+			"monitored_registry_attributes":            monitoring.MonitoredRegistryAttributes,
+		},
+	}
+}
+
+func flattenReadonlyFiles(files client.ReadonlyFiles) []map[string]interface{} {
+	//if !files.Enabled {
+	//	return []map[string]interface{}{}
+	//}
+	return []map[string]interface{}{
+		{
+			"enabled":                              files.Enabled,
+			"readonly_files":                       files.ReadonlyFiles,
+			"exceptional_readonly_files":           files.ExceptionalReadonlyFiles,
+			"readonly_files_processes":             files.ReadonlyFilesProcesses,
+			"exceptional_readonly_files_processes": files.ExceptionalReadonlyFilesProcesses,
+			"readonly_files_users":                 files.ReadonlyFilesUsers,
+			"exceptional_readonly_files_users":     files.ExceptionalReadonlyFilesUsers,
+		},
+	}
+}
+
+// maskSensitiveField masks sensitive values for logging and state display
+func maskSensitiveField(value string) string {
+	if value == "" {
+		return ""
+	}
+	// Return a masked version of the sensitive field
+	return "********"
+}
+
+func flattenTripwire(tripwire client.Tripwire) []map[string]interface{} {
+	//if !tripwire.Enabled {
+	//	return []map[string]interface{}{}
+	//}
+	return []map[string]interface{}{
+		{
+			"enabled":        tripwire.Enabled,
+			"user_id":        tripwire.UserID,
+			"user_password":  maskSensitiveField(tripwire.UserPassword),
+			"apply_on":       tripwire.ApplyOn,
+			"serverless_app": tripwire.ServerlessApp,
+		},
+	}
+}
+
+func flattenPortBlock(portBlock client.PortBlock) []map[string]interface{} {
+	//if !portBlock.Enabled {
+	//	return []map[string]interface{}{}
+	//}
+	return []map[string]interface{}{
+		{
+			"enabled":              portBlock.Enabled,
+			"block_inbound_ports":  portBlock.BlockInboundPorts,
+			"block_outbound_ports": portBlock.BlockOutboundPorts,
+		},
+	}
+}
+
+func flattenLinuxCapabilities(linuxCapabilities client.LinuxCapabilities) []map[string]interface{} {
+	//if !linuxCapabilities.Enabled {
+	//	return []map[string]interface{}{}
+	//}
+	return []map[string]interface{}{
+		{
+			"enabled":                   linuxCapabilities.Enabled,
+			"remove_linux_capabilities": linuxCapabilities.RemoveLinuxCapabilities,
+		},
+	}
+}
+
+func flattenPackageBlock(packageBlock client.PackageBlock) []map[string]interface{} {
+	//if !packageBlock.Enabled {
+	//	return []map[string]interface{}{}
+	//}
+	return []map[string]interface{}{
+		{
+			"enabled":                              packageBlock.Enabled,
+			"packages_black_list":                  packageBlock.PackagesBlackList,
+			"exceptional_block_packages_files":     packageBlock.ExceptionalBlockPackagesFiles,
+			"block_packages_users":                 packageBlock.BlockPackagesUsers,
+			"block_packages_processes":             packageBlock.BlockPackagesProcesses,
+			"exceptional_block_packages_users":     packageBlock.ExceptionalBlockPackagesUsers,
+			"exceptional_block_packages_processes": packageBlock.ExceptionalBlockPackagesProcesses,
+		},
+	}
+}
+
+func flattenFileBlock(fileBlock client.FileBlock) []map[string]interface{} {
+	//if !fileBlock.Enabled {
+	//	return []map[string]interface{}{}
+	//}
+	return []map[string]interface{}{
+		{
+			"enabled":                           fileBlock.Enabled,
+			"filename_block_list":               fileBlock.FilenameBlockList,
+			"exceptional_block_files":           fileBlock.ExceptionalBlockFiles,
+			"block_files_users":                 fileBlock.BlockFilesUsers,
+			"block_files_processes":             fileBlock.BlockFilesProcesses,
+			"exceptional_block_files_users":     fileBlock.ExceptionalBlockFilesUsers,
+			"exceptional_block_files_processes": fileBlock.ExceptionalBlockFilesProcesses,
+		},
+	}
+}
+
+func flattenWhitelistedOSUsers(whitelistedOsUsers client.WhitelistedOsUsers) []map[string]interface{} {
+	//if !whitelistedOsUsers.Enabled {
+	//	return []map[string]interface{}{}
+	//}
+	return []map[string]interface{}{
+		{
+			"enabled":          whitelistedOsUsers.Enabled,
+			"user_white_list":  whitelistedOsUsers.UserWhiteList,
+			"group_white_list": whitelistedOsUsers.GroupWhiteList,
+		},
+	}
+}
+
+func flattenBlacklistedOSUsers(blacklistedOsUsers client.BlacklistedOsUsers) []map[string]interface{} {
+	//if !blacklistedOsUsers.Enabled {
+	//	return []map[string]interface{}{}
+	//}
+	return []map[string]interface{}{
+		{
+			"enabled":          blacklistedOsUsers.Enabled,
+			"group_black_list": blacklistedOsUsers.GroupBlackList,
+			"user_black_list":  blacklistedOsUsers.UserBlackList,
+		},
+	}
+}
+
+func flattenAuditing(auditing client.Auditing) []map[string]interface{} {
+	//if !auditing.Enabled {
+	//	return []map[string]interface{}{}
+	//}
+	return []map[string]interface{}{
+		{
+			"enabled":                       auditing.Enabled,
+			"audit_all_processes":           auditing.AuditAllProcesses,
+			"audit_process_cmdline":         auditing.AuditProcessCmdline,
+			"audit_all_network":             auditing.AuditAllNetwork,
+			"audit_os_user_activity":        auditing.AuditOsUserActivity,
+			"audit_success_login":           auditing.AuditSuccessLogin,
+			"audit_failed_login":            auditing.AuditFailedLogin,
+			"audit_user_account_management": auditing.AuditUserAccountManagement,
+		},
+	}
+}
+
+func flattenLimitContainerPrivileges(limitContainerPrivileges client.LimitContainerPrivileges) []map[string]interface{} {
+	//if !limitContainerPrivileges.Enabled {
+	//	return []map[string]interface{}{}
+	//}
+	return []map[string]interface{}{
+		{
+			"enabled":                  limitContainerPrivileges.Enabled,
+			"privileged":               limitContainerPrivileges.Privileged,
+			"netmode":                  limitContainerPrivileges.Netmode,
+			"pidmode":                  limitContainerPrivileges.Pidmode,
+			"utsmode":                  limitContainerPrivileges.Utsmode,
+			"usermode":                 limitContainerPrivileges.Usermode,
+			"ipcmode":                  limitContainerPrivileges.Ipcmode,
+			"prevent_root_user":        limitContainerPrivileges.PreventRootUser,
+			"prevent_low_port_binding": limitContainerPrivileges.PreventLowPortBinding,
+			"block_add_capabilities":   limitContainerPrivileges.BlockAddCapabilities,
+			"use_host_user":            limitContainerPrivileges.UseHostUser,
+		},
+	}
+}
+
+func flattenRestrictedVolumes(restrictedVolumes client.RestrictedVolumes) []map[string]interface{} {
+	//if !restrictedVolumes.Enabled {
+	//	return []map[string]interface{}{}
+	//}
+	return []map[string]interface{}{
+		{
+			"enabled": restrictedVolumes.Enabled,
+			"volumes": restrictedVolumes.Volumes,
+		},
+	}
+}
+
+func flattenDriftPrevention(driftPrevention client.DriftPrevention) []map[string]interface{} {
+	//if !driftPrevention.Enabled {
+	//	return []map[string]interface{}{}
+	//}
+	return []map[string]interface{}{
+		{
+			"enabled":                  driftPrevention.Enabled,
+			"exec_lockdown":            driftPrevention.ExecLockdown,
+			"image_lockdown":           driftPrevention.ImageLockdown,
+			"exec_lockdown_white_list": driftPrevention.ExecLockdownWhiteList,
+		},
+	}
+}
+
+func flattenExecutableBlacklist(executableBlacklist client.ExecutableBlacklist) []map[string]interface{} {
+	//if !executableBlacklist.Enabled {
+	//	return []map[string]interface{}{}
+	//}
+	return []map[string]interface{}{
+		{
+			"enabled":     executableBlacklist.Enabled,
+			"executables": executableBlacklist.Executables,
+		},
+	}
+}
+
+func flattenAllowedRegistries(allowedRegistries client.AllowedRegistries) []map[string]interface{} {
+	//if !allowedRegistries.Enabled {
+	//	return []map[string]interface{}{}
+	//}
+	return []map[string]interface{}{
+		{
+			"enabled":            allowedRegistries.Enabled,
+			"allowed_registries": allowedRegistries.AllowedRegistries,
+		},
+	}
+}
+
+func flattenAllowedExecutables(allowedExecutables client.AllowedExecutables) []map[string]interface{} {
+	//if !allowedExecutables.Enabled {
+	//	return []map[string]interface{}{}
+	//}
+	return []map[string]interface{}{
+		{
+			"enabled":                allowedExecutables.Enabled,
+			"allow_executables":      allowedExecutables.AllowExecutables,
+			"separate_executables":   allowedExecutables.SeparateExecutables,
+			"allow_root_executables": allowedExecutables.AllowRootExecutables,
+		},
+	}
+}
+
+func flattenVariables(variables []interface{}) []client.Variable {
+	var result []client.Variable
+	for _, v := range variables {
+		val := v.(map[string]interface{})
+		result = append(result, client.Variable{
+			Attribute: val["attribute"].(string),
+			Value:     val["value"].(string),
+		})
+	}
+	return result
+}
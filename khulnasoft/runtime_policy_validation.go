@@ -0,0 +1,111 @@
+package khulnasoft
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// runtimePolicyLinuxCapabilities are the Linux capabilities, named as in the console without the CAP_ prefix
+var runtimePolicyLinuxCapabilities = []string{
+	"AUDIT_CONTROL", "AUDIT_READ", "AUDIT_WRITE", "BLOCK_SUSPEND", "BPF", "CHECKPOINT_RESTORE", "CHOWN",
+	"DAC_OVERRIDE", "DAC_READ_SEARCH", "FOWNER", "FSETID", "IPC_LOCK", "IPC_OWNER", "KILL", "LEASE",
+	"LINUX_IMMUTABLE", "MAC_ADMIN", "MAC_OVERRIDE", "MKNOD", "NET_ADMIN", "NET_BIND_SERVICE", "NET_BROADCAST",
+	"NET_RAW", "PERFMON", "SETFCAP", "SETGID", "SETPCAP", "SETUID", "SYSLOG", "SYS_ADMIN", "SYS_BOOT",
+	"SYS_CHROOT", "SYS_MODULE", "SYS_NICE", "SYS_PACCT", "SYS_PTRACE", "SYS_RAWIO", "SYS_RESOURCE", "SYS_TIME",
+	"SYS_TTY_CONFIG", "WAKE_ALARM",
+}
+
+// runtimePolicyMalwareActions are the actions of the real-time malware protection, empty meaning alert
+var runtimePolicyMalwareActions = []string{"", "alert", "block"}
+
+// runtimePolicyTripwireApplyOn are the places a honeypot is applied on
+var runtimePolicyTripwireApplyOn = []string{"Environment Variable", "Layer", "File", "Function"}
+
+// runtimePolicySecurityProfiles are the default security profiles of the workloads, besides Localhost/<profile>
+var runtimePolicySecurityProfiles = []string{"", "RuntimeDefault", "Unconfined"}
+
+var runtimePolicyLocalhostSecurityProfile = regexp.MustCompile(`^Localhost/[^\s/][^\s]*$`)
+
+// validateRuntimePolicyLinuxCapability accepts the Linux capabilities in any case, with or without the CAP_ prefix
+func validateRuntimePolicyLinuxCapability(v interface{}, k string) ([]string, []error) {
+	capability := normalizeRuntimePolicyLinuxCapability(v.(string))
+	for _, c := range runtimePolicyLinuxCapabilities {
+		if capability == c {
+			return nil, nil
+		}
+	}
+	return nil, []error{fmt.Errorf("expected %s to be one of %q, with or without the CAP_ prefix, got %q", k, runtimePolicyLinuxCapabilities, v)}
+}
+
+// normalizeRuntimePolicyLinuxCapability names a Linux capability as the console does, in upper case without the
+// CAP_ prefix
+func normalizeRuntimePolicyLinuxCapability(capability string) string {
+	return strings.TrimPrefix(strings.ToUpper(capability), "CAP_")
+}
+
+// normalizeRuntimePolicyLinuxCapabilities names the Linux capabilities as the console does
+func normalizeRuntimePolicyLinuxCapabilities(capabilities []string) []string {
+	normalized := make([]string, len(capabilities))
+	for i, capability := range capabilities {
+		normalized[i] = normalizeRuntimePolicyLinuxCapability(capability)
+	}
+	return normalized
+}
+
+// suppressEquivalentLinuxCapabilityDiff suppresses the diff between two names of the same Linux capability
+func suppressEquivalentLinuxCapabilityDiff(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeRuntimePolicyLinuxCapability(old) == normalizeRuntimePolicyLinuxCapability(new)
+}
+
+var validateRuntimePolicyMalwareAction = validation.StringInSlice(runtimePolicyMalwareActions, true)
+
+var validateRuntimePolicyTripwireApplyOn = validation.StringInSlice(runtimePolicyTripwireApplyOn, true)
+
+func validateRuntimePolicySecurityProfile(v interface{}, k string) ([]string, []error) {
+	profile := v.(string)
+	for _, p := range runtimePolicySecurityProfiles {
+		if profile == p {
+			return nil, nil
+		}
+	}
+	if runtimePolicyLocalhostSecurityProfile.MatchString(profile) {
+		return nil, nil
+	}
+	return nil, []error{fmt.Errorf("expected %s to be one of %q or Localhost/<profile>, got %q", k, runtimePolicySecurityProfiles[1:], profile)}
+}
+
+// validateRuntimePolicyPort accepts a port or an inclusive port range, e.g. 22 or 1-1024
+func validateRuntimePolicyPort(v interface{}, k string) ([]string, []error) {
+	value := v.(string)
+	bounds := strings.SplitN(value, "-", 2)
+	var ports []int
+	for _, bound := range bounds {
+		port, err := strconv.Atoi(bound)
+		if err != nil || port < 1 || port > 65535 {
+			return nil, []error{fmt.Errorf("expected %s to be a port between 1 and 65535 or a port range such as 1-1024, got %q", k, value)}
+		}
+		ports = append(ports, port)
+	}
+	if len(ports) == 2 && ports[0] > ports[1] {
+		return nil, []error{fmt.Errorf("expected %s to be a port range with its first port lower than its last port, got %q", k, value)}
+	}
+	return nil, nil
+}
+
+// validateRuntimePolicyIPOrCIDR accepts an IP address or a network in CIDR notation
+func validateRuntimePolicyIPOrCIDR(v interface{}, k string) ([]string, []error) {
+	value := v.(string)
+	if net.ParseIP(value) != nil {
+		return nil, nil
+	}
+	if _, _, err := net.ParseCIDR(value); err == nil {
+		return nil, nil
+	}
+	return nil, []error{fmt.Errorf("expected %s to be an IP address or a CIDR such as 10.0.0.0/8, got %q", k, value)}
+}
@@ -0,0 +1,68 @@
+package khulnasoft
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestRuntimePolicyValidation(t *testing.T) {
+	cases := []struct {
+		name     string
+		validate schema.SchemaValidateFunc
+		valid    []string
+		invalid  []string
+	}{
+		{
+			name:     "linux capability",
+			validate: validateRuntimePolicyLinuxCapability,
+			valid:    []string{"NET_RAW", "SYS_ADMIN", "CHECKPOINT_RESTORE", "CAP_NET_RAW", "net_raw", "cap_sys_admin"},
+			invalid:  []string{"NET_RAWS", "CAP_", "CAPNET_RAW", ""},
+		},
+		{
+			name:     "port",
+			validate: validateRuntimePolicyPort,
+			valid:    []string{"22", "1-1024", "65535", "8080-8080"},
+			invalid:  []string{"0", "65536", "1024-1", "80-", "-80", "http", "1-2-3", " 22", ""},
+		},
+		{
+			name:     "malware action",
+			validate: validateRuntimePolicyMalwareAction,
+			valid:    []string{"alert", "Alert", "block", ""},
+			invalid:  []string{"quarantine"},
+		},
+		{
+			name:     "ip or cidr",
+			validate: validateRuntimePolicyIPOrCIDR,
+			valid:    []string{"10.0.0.1", "10.0.0.0/8", "2001:db8::1", "2001:db8::/32"},
+			invalid:  []string{"10.0.0.256", "10.0.0.0/33", "example.com", ""},
+		},
+		{
+			name:     "tripwire apply on",
+			validate: validateRuntimePolicyTripwireApplyOn,
+			valid:    []string{"Environment Variable", "layer", "File", "Function"},
+			invalid:  []string{"Env", ""},
+		},
+		{
+			name:     "security profile",
+			validate: validateRuntimePolicySecurityProfile,
+			valid:    []string{"", "RuntimeDefault", "Unconfined", "Localhost/profiles/audit.json"},
+			invalid:  []string{"runtime_default", "Localhost/", "Localhost//abs", "Localhost/a b"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			for _, v := range c.valid {
+				if _, errs := c.validate(v, "attribute"); len(errs) > 0 {
+					t.Errorf("%q: unexpected errors %v", v, errs)
+				}
+			}
+			for _, v := range c.invalid {
+				if _, errs := c.validate(v, "attribute"); len(errs) == 0 {
+					t.Errorf("%q: expected an error", v)
+				}
+			}
+		})
+	}
+}
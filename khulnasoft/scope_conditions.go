@@ -0,0 +1,263 @@
+package khulnasoft
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// scopeAttributePattern matches scope attributes such as kubernetes.namespace or image.label
+var scopeAttributePattern = regexp.MustCompile(`^[a-z0-9_]+(\.[a-z0-9_]+)+$`)
+
+// scopeConditionsSchema returns the schema of scope_conditions, the structured form of a scope the provider compiles
+// into a scope expression and its variables
+func scopeConditionsSchema(conflictsWith ...string) *schema.Schema {
+	return &schema.Schema{
+		Type: schema.TypeList,
+		Description: "Structured alternative to the scope expression and variables, compiled by the provider into them. " +
+			"The conditions and groups are combined with `operator`, the conditions of each group with the `operator` of the group, " +
+			"e.g. a condition and a group of two conditions combined with `or` compile to `v1 && (v2 || v3)`.",
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: conflictsWith,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"operator":  scopeOperatorSchema("Operator combining the conditions and groups"),
+				"condition": scopeConditionSchema(0),
+				"group": {
+					Type:        schema.TypeList,
+					Description: "Group of conditions, combined with the operator of the group.",
+					Optional:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"operator":  scopeOperatorSchema("Operator combining the conditions of the group"),
+							"condition": scopeConditionSchema(1),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func scopeOperatorSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  description + ", `and` or `or`.",
+		Optional:     true,
+		Default:      "and",
+		ValidateFunc: validation.StringInSlice([]string{"and", "or"}, false),
+	}
+}
+
+func scopeConditionSchema(minItems int) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Condition on an attribute of the workload.",
+		Optional:    minItems == 0,
+		Required:    minItems > 0,
+		MinItems:    minItems,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"attribute": {
+					Type:         schema.TypeString,
+					Description:  "Scope attribute, e.g. `kubernetes.namespace` or `image.label`.",
+					Required:     true,
+					ValidateFunc: validation.StringMatch(scopeAttributePattern, "must be a scope attribute such as kubernetes.namespace"),
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Description: "Label key, required by the label attributes.",
+					Optional:    true,
+				},
+				"value": {
+					Type:        schema.TypeString,
+					Description: "Value of the attribute, `*` matching any sequence of characters.",
+					Required:    true,
+				},
+			},
+		},
+	}
+}
+
+// compileScopeConditions compiles the scope_conditions block into a scope expression and its variables, the
+// conditions being numbered before the conditions of the groups
+func compileScopeConditions(conditions []interface{}) (string, []scopeVariable) {
+	if len(conditions) == 0 || conditions[0] == nil {
+		return "", nil
+	}
+	block := conditions[0].(map[string]interface{})
+
+	var variables []scopeVariable
+	addConditions := func(list []interface{}) []string {
+		var refs []string
+		for _, c := range list {
+			condition := c.(map[string]interface{})
+			variables = append(variables, scopeVariable{
+				attribute: condition["attribute"].(string),
+				name:      condition["name"].(string),
+				value:     condition["value"].(string),
+			})
+			refs = append(refs, fmt.Sprintf("v%d", len(variables)))
+		}
+		return refs
+	}
+
+	operands := addConditions(block["condition"].([]interface{}))
+	for _, g := range block["group"].([]interface{}) {
+		group := g.(map[string]interface{})
+		refs := addConditions(group["condition"].([]interface{}))
+		operand := joinScopeOperands(refs, group["operator"].(string))
+		if len(refs) > 1 {
+			operand = "(" + operand + ")"
+		}
+		operands = append(operands, operand)
+	}
+	return joinScopeOperands(operands, block["operator"].(string)), variables
+}
+
+func joinScopeOperands(operands []string, operator string) string {
+	if operator == "or" {
+		return strings.Join(operands, " || ")
+	}
+	return strings.Join(operands, " && ")
+}
+
+// validateScopeConditions validates what the schema of scope_conditions cannot
+func validateScopeConditions(conditions []interface{}) error {
+	if len(conditions) == 0 || conditions[0] == nil {
+		return nil
+	}
+	block := conditions[0].(map[string]interface{})
+	if len(block["condition"].([]interface{})) == 0 && len(block["group"].([]interface{})) == 0 {
+		return fmt.Errorf("scope_conditions must have at least one condition or group")
+	}
+	_, variables := compileScopeConditions(conditions)
+	for _, v := range variables {
+		if strings.HasSuffix(v.attribute, ".label") && v.name == "" {
+			return fmt.Errorf("scope_conditions: the condition on %s = %q must set name to the label key", v.attribute, v.value)
+		}
+	}
+	return nil
+}
+
+// validateScopeExpression validates the syntax of a scope expression
+func validateScopeExpression(v interface{}, k string) ([]string, []error) {
+	if _, err := parseScopeExpression(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%q: %v", k, err)}
+	}
+	return nil, nil
+}
+
+// validateScopeVariableReferences validates that a scope expression only references the variables of the scope
+func validateScopeVariableReferences(expression string, count int) error {
+	tokens, err := tokenizeScopeExpression(expression)
+	if err != nil {
+		// reported by validateScopeExpression
+		return nil
+	}
+	for _, token := range tokens {
+		if !strings.HasPrefix(token.value, "v") {
+			continue
+		}
+		if n, err := parseScopeVariableRef(token.value); err == nil && n > count {
+			if count == 0 {
+				return fmt.Errorf("scope expression %q references %s, but the scope has no variables", expression, token.value)
+			}
+			return fmt.Errorf("scope expression %q references %s, but the scope only has %d variables (v1 to v%d)", expression, token.value, count, count)
+		}
+	}
+	return nil
+}
+
+// validateScopeDiff validates the variable references of the scope configured with the expression and variables
+// attributes, which can be nested, e.g. scope.0.expression
+func validateScopeDiff(d *schema.ResourceDiff, expressionKey, variablesKey string) error {
+	if !d.NewValueKnown(expressionKey) || !d.NewValueKnown(variablesKey) {
+		return nil
+	}
+	expression, _ := d.Get(expressionKey).(string)
+	variables, _ := d.Get(variablesKey).([]interface{})
+	return validateScopeVariableReferences(expression, len(variables))
+}
+
+// validateScopeConditionsDiff validates the scope_conditions of a plan
+func validateScopeConditionsDiff(d *schema.ResourceDiff) error {
+	if !d.NewValueKnown("scope_conditions") {
+		return nil
+	}
+	return validateScopeConditions(d.Get("scope_conditions").([]interface{}))
+}
+
+// flattenScopeConditions clears scope_conditions when they no longer compile to the scope of the console, so that a
+// scope changed outside of Terraform shows up as a diff
+func flattenScopeConditions(d *schema.ResourceData, expression string, variables []scopeVariable) {
+	if _, ok := d.GetOk("scope_conditions"); ok && !scopeConditionsMatch(d, expression, variables) {
+		d.Set("scope_conditions", nil)
+	}
+}
+
+// scopeConditionsMatch reports whether the configured scope_conditions compile to the given scope
+func scopeConditionsMatch(d *schema.ResourceData, expression string, variables []scopeVariable) bool {
+	compiledExpression, compiledVariables := compileScopeConditions(d.Get("scope_conditions").([]interface{}))
+	if compiledExpression != expression || len(compiledVariables) != len(variables) {
+		return false
+	}
+	for i := range variables {
+		if compiledVariables[i] != variables[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func scopeFromVariables(expression string, variables []scopeVariable) client.Scope {
+	scope := client.Scope{Expression: expression, Variables: make([]client.Variable, 0)}
+	for _, v := range variables {
+		scope.Variables = append(scope.Variables, client.Variable{Attribute: v.attribute, Name: v.name, Value: v.value})
+	}
+	return scope
+}
+
+func assuranceScopeFromVariables(expression string, variables []scopeVariable) client.Scopes {
+	scope := client.Scopes{Expression: expression, Variables: make([]client.VariableI, 0)}
+	for _, v := range variables {
+		scope.Variables = append(scope.Variables, client.VariableI{Attribute: v.attribute, Name: v.name, Value: v.value})
+	}
+	return scope
+}
+
+// runtimePolicyScopeCustomizeDiff validates the variable references of the scopes of a runtime policy
+func runtimePolicyScopeCustomizeDiff(d *schema.ResourceDiff) error {
+	if err := validateScopeDiff(d, "scope_expression", "scope_variables"); err != nil {
+		return err
+	}
+	if len(d.Get("scope").([]interface{})) > 0 {
+		if err := validateScopeDiff(d, "scope.0.expression", "scope.0.variables"); err != nil {
+			return err
+		}
+	}
+	if len(d.Get("bypass_scope").([]interface{})) > 0 && len(d.Get("bypass_scope.0.scope").([]interface{})) > 0 {
+		if err := validateScopeDiff(d, "bypass_scope.0.scope.0.expression", "bypass_scope.0.scope.0.variables"); err != nil {
+			return fmt.Errorf("bypass_scope: %v", err)
+		}
+	}
+	return validateScopeConditionsDiff(d)
+}
+
+// assurancePolicyScopeCustomizeDiff validates the variable references of the scope of an assurance policy
+func assurancePolicyScopeCustomizeDiff(d *schema.ResourceDiff) error {
+	if d.NewValueKnown("scope") {
+		for _, v := range d.Get("scope").(*schema.Set).List() {
+			scope := v.(map[string]interface{})
+			if err := validateScopeVariableReferences(scope["expression"].(string), len(scope["variables"].([]interface{}))); err != nil {
+				return err
+			}
+		}
+	}
+	return validateScopeConditionsDiff(d)
+}
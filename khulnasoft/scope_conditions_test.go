@@ -0,0 +1,102 @@
+package khulnasoft
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCompileScopeConditions(t *testing.T) {
+	condition := func(attribute, name, value string) interface{} {
+		return map[string]interface{}{"attribute": attribute, "name": name, "value": value}
+	}
+	group := func(operator string, conditions ...interface{}) interface{} {
+		return map[string]interface{}{"operator": operator, "condition": conditions}
+	}
+	block := func(operator string, conditions, groups []interface{}) []interface{} {
+		return []interface{}{map[string]interface{}{"operator": operator, "condition": conditions, "group": groups}}
+	}
+
+	cases := []struct {
+		name       string
+		conditions []interface{}
+		expression string
+		variables  int
+	}{
+		{
+			name:       "conditions",
+			conditions: block("and", []interface{}{condition("kubernetes.cluster", "", "prod"), condition("kubernetes.namespace", "", "payments")}, nil),
+			expression: "v1 && v2",
+			variables:  2,
+		},
+		{
+			name: "condition and group",
+			conditions: block("and", []interface{}{condition("kubernetes.cluster", "", "prod")}, []interface{}{
+				group("or", condition("kubernetes.namespace", "", "payments"), condition("kubernetes.label", "app", "checkout")),
+			}),
+			expression: "v1 && (v2 || v3)",
+			variables:  3,
+		},
+		{
+			name: "groups",
+			conditions: block("or", nil, []interface{}{
+				group("and", condition("kubernetes.cluster", "", "prod"), condition("kubernetes.namespace", "", "payments")),
+				group("and", condition("os.hostname", "", "bastion")),
+			}),
+			expression: "(v1 && v2) || v3",
+			variables:  3,
+		},
+		{
+			name:       "none",
+			conditions: nil,
+			expression: "",
+			variables:  0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			expression, variables := compileScopeConditions(tc.conditions)
+			if expression != tc.expression || len(variables) != tc.variables {
+				t.Errorf("expected %q with %d variables, got %q with %d variables", tc.expression, tc.variables, expression, len(variables))
+			}
+			if _, err := parseScopeExpression(expression); err != nil {
+				t.Errorf("compiled expression does not parse: %v", err)
+			}
+		})
+	}
+
+	err := validateScopeConditions(block("and", nil, nil))
+	if err == nil || !strings.Contains(err.Error(), "at least one condition or group") {
+		t.Errorf("expected an error for empty scope conditions, got %v", err)
+	}
+	err = validateScopeConditions(block("and", []interface{}{condition("image.label", "", "prod")}, nil))
+	if err == nil || !strings.Contains(err.Error(), "must set name to the label key") {
+		t.Errorf("expected an error for a label condition without name, got %v", err)
+	}
+}
+
+func TestValidateScopeVariableReferences(t *testing.T) {
+	cases := []struct {
+		expression string
+		count      int
+		valid      bool
+	}{
+		{"v1 && (v2 || v3)", 3, true},
+		{"v1 && (v2 || v3)", 2, false},
+		{"v1", 0, false},
+		{"", 0, true},
+		{"v1", 5, true},
+	}
+
+	for _, tc := range cases {
+		err := validateScopeVariableReferences(tc.expression, tc.count)
+		if (err == nil) != tc.valid {
+			t.Errorf("%q with %d variables: expected valid %v, got %v", tc.expression, tc.count, tc.valid, err)
+		}
+	}
+
+	_, errs := validateScopeExpression("v1 &&& v2", "scope_expression")
+	if len(errs) != 1 {
+		t.Errorf("expected an error for an invalid expression, got %v", errs)
+	}
+}
@@ -0,0 +1,265 @@
+package khulnasoft
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/khulnasoft/terraform-provider-khulnasoft/client"
+)
+
+// scopeExpression is a parsed scope expression such as "v1 && (v2 || v3)", whose operands reference the scope
+// variables by position, v1 being the first variable.
+type scopeExpression interface {
+	// eval evaluates the expression given whether each variable matches
+	eval(matches []bool) bool
+}
+
+// scopeVariableRef references a scope variable by its zero based position
+type scopeVariableRef int
+
+func (v scopeVariableRef) eval(matches []bool) bool {
+	return int(v) < len(matches) && matches[v]
+}
+
+type scopeAnd struct {
+	left, right scopeExpression
+}
+
+func (e scopeAnd) eval(matches []bool) bool {
+	return e.left.eval(matches) && e.right.eval(matches)
+}
+
+type scopeOr struct {
+	left, right scopeExpression
+}
+
+func (e scopeOr) eval(matches []bool) bool {
+	return e.left.eval(matches) || e.right.eval(matches)
+}
+
+type scopeToken struct {
+	value string
+	pos   int
+}
+
+// parseScopeExpression parses a scope expression, && taking precedence over ||. An empty expression is parsed as nil.
+func parseScopeExpression(expression string) (scopeExpression, error) {
+	tokens, err := tokenizeScopeExpression(expression)
+	if err != nil {
+		return nil, fmt.Errorf("invalid scope expression %q: %v", expression, err)
+	}
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+
+	p := &scopeParser{tokens: tokens}
+	expr, err := p.parseOr()
+	if err == nil && p.pos < len(p.tokens) {
+		err = fmt.Errorf("unexpected %q at position %d", p.tokens[p.pos].value, p.tokens[p.pos].pos+1)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid scope expression %q: %v", expression, err)
+	}
+	return expr, nil
+}
+
+func tokenizeScopeExpression(expression string) ([]scopeToken, error) {
+	var tokens []scopeToken
+	for i := 0; i < len(expression); {
+		switch c := expression[i]; {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(' || c == ')':
+			tokens = append(tokens, scopeToken{value: string(c), pos: i})
+			i++
+		case strings.HasPrefix(expression[i:], "&&") || strings.HasPrefix(expression[i:], "||"):
+			tokens = append(tokens, scopeToken{value: expression[i : i+2], pos: i})
+			i += 2
+		case c == 'v' || c == 'V':
+			j := i + 1
+			for j < len(expression) && expression[j] >= '0' && expression[j] <= '9' {
+				j++
+			}
+			if j == i+1 {
+				return nil, fmt.Errorf("expected a variable number after %q at position %d", string(c), i+1)
+			}
+			tokens = append(tokens, scopeToken{value: "v" + expression[i+1:j], pos: i})
+			i = j
+		default:
+			return nil, fmt.Errorf("unexpected %q at position %d", string(c), i+1)
+		}
+	}
+	return tokens, nil
+}
+
+type scopeParser struct {
+	tokens []scopeToken
+	pos    int
+}
+
+func (p *scopeParser) parseOr() (scopeExpression, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("||") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = scopeOr{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *scopeParser) parseAnd() (scopeExpression, error) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("&&") {
+		right, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		left = scopeAnd{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *scopeParser) parseOperand() (scopeExpression, error) {
+	if p.pos >= len(p.tokens) {
+		return nil, fmt.Errorf("unexpected end of expression, expected a variable or (")
+	}
+	token := p.tokens[p.pos]
+	p.pos++
+	switch {
+	case token.value == "(":
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.accept(")") {
+			return nil, fmt.Errorf("missing ) closing the ( at position %d", token.pos+1)
+		}
+		return expr, nil
+	case strings.HasPrefix(token.value, "v"):
+		n, err := parseScopeVariableRef(token.value)
+		if err != nil {
+			return nil, fmt.Errorf("%v at position %d", err, token.pos+1)
+		}
+		return scopeVariableRef(n - 1), nil
+	default:
+		return nil, fmt.Errorf("unexpected %q at position %d, expected a variable or (", token.value, token.pos+1)
+	}
+}
+
+// parseScopeVariableRef returns the number of a variable reference such as v2, variables being numbered from 1
+func parseScopeVariableRef(ref string) (int, error) {
+	n, err := strconv.Atoi(ref[1:])
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("invalid variable %q, variables are numbered from v1", ref)
+	}
+	return n, nil
+}
+
+func (p *scopeParser) accept(value string) bool {
+	if p.pos < len(p.tokens) && p.tokens[p.pos].value == value {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// matchScopeValue reports whether a workload attribute value matches a scope variable value, in which * matches any
+// sequence of characters
+func matchScopeValue(pattern, value string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == value
+	}
+	if !strings.HasPrefix(value, parts[0]) {
+		return false
+	}
+	value = value[len(parts[0]):]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(value, part)
+		if i < 0 {
+			return false
+		}
+		value = value[i+len(part):]
+	}
+	return strings.HasSuffix(value, parts[len(parts)-1])
+}
+
+// scopeVariable is a scope variable of a runtime policy, an assurance policy or an application scope. The name of
+// label variables is the label key.
+type scopeVariable struct {
+	attribute string
+	name      string
+	value     string
+}
+
+// scopeWorkload is the workload a scope is evaluated against: its attribute values by scope attribute, e.g.
+// kubernetes.namespace, and its labels, matched by the *.label attributes.
+type scopeWorkload struct {
+	attributes map[string]string
+	labels     map[string]string
+}
+
+func (w scopeWorkload) matchVariable(v scopeVariable) bool {
+	if strings.HasSuffix(v.attribute, ".label") {
+		value, ok := w.labels[v.name]
+		return ok && matchScopeValue(v.value, value)
+	}
+	value, ok := w.attributes[v.attribute]
+	return ok && matchScopeValue(v.value, value)
+}
+
+// matchScope evaluates a scope against a workload. A scope without expression and variables matches every workload,
+// and a scope without expression requires all its variables to match.
+func matchScope(expression string, variables []scopeVariable, w scopeWorkload) (bool, error) {
+	expr, err := parseScopeExpression(expression)
+	if err != nil {
+		return false, err
+	}
+
+	matches := make([]bool, len(variables))
+	for i, v := range variables {
+		matches[i] = w.matchVariable(v)
+	}
+	if expr == nil {
+		for _, match := range matches {
+			if !match {
+				return false, nil
+			}
+		}
+		return true, nil
+	}
+	return expr.eval(matches), nil
+}
+
+func scopeVariablesFromScope(variables []client.Variable) []scopeVariable {
+	var result []scopeVariable
+	for _, v := range variables {
+		result = append(result, scopeVariable{attribute: v.Attribute, name: v.Name, value: v.Value})
+	}
+	return result
+}
+
+func scopeVariablesFromApplicationScope(variables []client.Variables) []scopeVariable {
+	var result []scopeVariable
+	for _, v := range variables {
+		result = append(result, scopeVariable{attribute: v.Attribute, name: v.Name, value: v.Value})
+	}
+	return result
+}
+
+func scopeVariablesFromAssuranceScope(variables []client.VariableI) []scopeVariable {
+	var result []scopeVariable
+	for _, v := range variables {
+		result = append(result, scopeVariable{attribute: v.Attribute, name: v.Name, value: v.Value})
+	}
+	return result
+}
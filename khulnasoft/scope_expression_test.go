@@ -0,0 +1,105 @@
+package khulnasoft
+
+import (
+	"testing"
+)
+
+func TestParseScopeExpression(t *testing.T) {
+	cases := []struct {
+		expression string
+		matches    []bool
+		expected   bool
+	}{
+		{"v1", []bool{true}, true},
+		{"v1 && v2", []bool{true, false}, false},
+		{"v1 || v2", []bool{false, true}, true},
+		{"v1 && (v2 || v3)", []bool{true, false, true}, true},
+		{"v1 && (v2 || v3)", []bool{false, true, true}, false},
+		// && takes precedence over ||
+		{"v1 || v2 && v3", []bool{true, false, false}, true},
+		{"(v1 || v2) && v3", []bool{true, false, false}, false},
+		{"((v1))", []bool{true}, true},
+		{"V1&&v2", []bool{true, true}, true},
+	}
+
+	for _, tc := range cases {
+		expr, err := parseScopeExpression(tc.expression)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", tc.expression, err)
+			continue
+		}
+		if got := expr.eval(tc.matches); got != tc.expected {
+			t.Errorf("%q with %v: expected %v, got %v", tc.expression, tc.matches, tc.expected, got)
+		}
+	}
+
+	for _, expression := range []string{"v1 &&", "v1 v2", "(v1 || v2", "v1)", "v0", "v", "v1 & v2", "v1 and v2", "&& v1"} {
+		if _, err := parseScopeExpression(expression); err == nil {
+			t.Errorf("%q: expected an error", expression)
+		}
+	}
+
+	if expr, err := parseScopeExpression("  "); err != nil || expr != nil {
+		t.Errorf("empty expression: expected nil, got %v, %v", expr, err)
+	}
+}
+
+func TestMatchScopeValue(t *testing.T) {
+	cases := []struct {
+		pattern  string
+		value    string
+		expected bool
+	}{
+		{"prod", "prod", true},
+		{"prod", "production", false},
+		{"prod*", "production", true},
+		{"*", "", true},
+		{"*-prod", "payments-prod", true},
+		{"library/*:*", "library/nginx:1.25", true},
+		{"a*b*a", "aba", true},
+		{"a*b*a", "ab", false},
+	}
+
+	for _, tc := range cases {
+		if got := matchScopeValue(tc.pattern, tc.value); got != tc.expected {
+			t.Errorf("%q against %q: expected %v, got %v", tc.pattern, tc.value, tc.expected, got)
+		}
+	}
+}
+
+func TestMatchScope(t *testing.T) {
+	workload := scopeWorkload{
+		attributes: map[string]string{"kubernetes.namespace": "payments", "kubernetes.cluster": "prod-eu"},
+		labels:     map[string]string{"app": "checkout"},
+	}
+	variables := []scopeVariable{
+		{attribute: "kubernetes.cluster", value: "prod-*"},
+		{attribute: "kubernetes.namespace", value: "search"},
+		{attribute: "kubernetes.label", name: "app", value: "checkout"},
+	}
+
+	cases := []struct {
+		expression string
+		variables  []scopeVariable
+		expected   bool
+	}{
+		{"v1 && (v2 || v3)", variables, true},
+		{"v1 && v2", variables, false},
+		{"", nil, true},
+		// without expression all the variables must match
+		{"", variables, false},
+		{"", variables[:1], true},
+		{"v4 || v1", variables, true},
+	}
+
+	for _, tc := range cases {
+		got, err := matchScope(tc.expression, tc.variables, workload)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", tc.expression, err)
+			continue
+		}
+		if got != tc.expected {
+			t.Errorf("%q: expected %v, got %v", tc.expression, tc.expected, got)
+		}
+	}
+}